@@ -45,6 +45,7 @@ type Config struct {
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	ProtectResourcesConfig         *ProtectResourcesConfig
 	Region                         string
 	RetryMode                      aws.RetryMode
 	S3UsePathStyle                 bool
@@ -211,6 +212,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.accountID = accountID
 	client.defaultTagsConfig = c.DefaultTagsConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
+	client.protectResourcesConfig = c.ProtectResourcesConfig
 	client.tagPolicyConfig = c.TagPolicyConfig
 	client.terraformVersion = c.TerraformVersion
 
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"path"
+
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// ProtectResourcesConfig contains options related to preventing the deletion of resources.
+type ProtectResourcesConfig struct {
+	Rules []ProtectResourcesRule
+}
+
+// ProtectResourcesRule selects resources to protect from deletion.
+// A resource is selected if its type matches any of the ResourceTypes patterns
+// and its tags contain all of Tags.
+// An empty ResourceTypes or Tags matches any resource.
+type ProtectResourcesRule struct {
+	// ResourceTypes are Terraform resource type name patterns, e.g. "aws_s3_bucket" or "aws_kms_*".
+	// Pattern syntax is that of path.Match.
+	ResourceTypes []string
+	// Tags are the tags (keys and values) that a resource must have.
+	Tags tftags.KeyValueTags
+}
+
+// Protects returns whether the deletion of a resource of the specified type and with the specified tags
+// is prevented, and if so, the matching rule.
+func (c *ProtectResourcesConfig) Protects(typeName string, tags tftags.KeyValueTags) (ProtectResourcesRule, bool) {
+	if c == nil {
+		return ProtectResourcesRule{}, false
+	}
+
+	for _, rule := range c.Rules {
+		if rule.matches(typeName, tags) {
+			return rule, true
+		}
+	}
+
+	return ProtectResourcesRule{}, false
+}
+
+// ValidateResourceTypePattern returns an error if a resource type name pattern is malformed.
+func ValidateResourceTypePattern(pattern string) error {
+	_, err := path.Match(pattern, "")
+	return err
+}
+
+func (r ProtectResourcesRule) matches(typeName string, tags tftags.KeyValueTags) bool {
+	if len(r.ResourceTypes) > 0 {
+		var matched bool
+		for _, pattern := range r.ResourceTypes {
+			// Patterns are validated during provider configuration.
+			if ok, _ := path.Match(pattern, typeName); ok {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	return tags.ContainsAll(r.Tags)
+}
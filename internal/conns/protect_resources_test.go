@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestProtectResourcesConfigProtects(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	testCases := map[string]struct {
+		config   *ProtectResourcesConfig
+		typeName string
+		tags     map[string]string
+		expected bool
+	}{
+		"nil config": {
+			typeName: "aws_s3_bucket",
+		},
+		"no rules": {
+			config:   &ProtectResourcesConfig{},
+			typeName: "aws_s3_bucket",
+		},
+		"exact type": {
+			config: &ProtectResourcesConfig{
+				Rules: []ProtectResourcesRule{
+					{ResourceTypes: []string{"aws_s3_bucket"}},
+				},
+			},
+			typeName: "aws_s3_bucket",
+			expected: true,
+		},
+		"exact type no match": {
+			config: &ProtectResourcesConfig{
+				Rules: []ProtectResourcesRule{
+					{ResourceTypes: []string{"aws_s3_bucket"}},
+				},
+			},
+			typeName: "aws_s3_bucket_policy",
+		},
+		"glob type": {
+			config: &ProtectResourcesConfig{
+				Rules: []ProtectResourcesRule{
+					{ResourceTypes: []string{"aws_s3_bucket", "aws_kms_*"}},
+				},
+			},
+			typeName: "aws_kms_key",
+			expected: true,
+		},
+		"tags": {
+			config: &ProtectResourcesConfig{
+				Rules: []ProtectResourcesRule{
+					{Tags: tftags.New(ctx, map[string]string{"protected": "true"})},
+				},
+			},
+			typeName: "aws_instance",
+			tags:     map[string]string{"protected": "true", "Name": "test"},
+			expected: true,
+		},
+		"tags value no match": {
+			config: &ProtectResourcesConfig{
+				Rules: []ProtectResourcesRule{
+					{Tags: tftags.New(ctx, map[string]string{"protected": "true"})},
+				},
+			},
+			typeName: "aws_instance",
+			tags:     map[string]string{"protected": "false"},
+		},
+		"type and tags": {
+			config: &ProtectResourcesConfig{
+				Rules: []ProtectResourcesRule{
+					{
+						ResourceTypes: []string{"aws_s3_*"},
+						Tags:          tftags.New(ctx, map[string]string{"env": "prod"}),
+					},
+				},
+			},
+			typeName: "aws_s3_bucket",
+			tags:     map[string]string{"env": "prod"},
+			expected: true,
+		},
+		"type and tags type no match": {
+			config: &ProtectResourcesConfig{
+				Rules: []ProtectResourcesRule{
+					{
+						ResourceTypes: []string{"aws_s3_*"},
+						Tags:          tftags.New(ctx, map[string]string{"env": "prod"}),
+					},
+				},
+			},
+			typeName: "aws_kms_key",
+			tags:     map[string]string{"env": "prod"},
+		},
+		"type and tags tags no match": {
+			config: &ProtectResourcesConfig{
+				Rules: []ProtectResourcesRule{
+					{
+						ResourceTypes: []string{"aws_s3_*"},
+						Tags:          tftags.New(ctx, map[string]string{"env": "prod"}),
+					},
+				},
+			},
+			typeName: "aws_s3_bucket",
+		},
+		"any rule": {
+			config: &ProtectResourcesConfig{
+				Rules: []ProtectResourcesRule{
+					{ResourceTypes: []string{"aws_s3_bucket"}},
+					{Tags: tftags.New(ctx, map[string]string{"env": "prod"})},
+				},
+			},
+			typeName: "aws_kms_key",
+			tags:     map[string]string{"env": "prod"},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, got := testCase.config.Protects(testCase.typeName, tftags.New(ctx, testCase.tags))
+
+			if got, want := got, testCase.expected; got != want {
+				t.Errorf("Protects = %t, want %t", got, want)
+			}
+		})
+	}
+}
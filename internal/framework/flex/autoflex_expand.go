@@ -100,6 +100,15 @@ func autoExpandConvert(ctx context.Context, from, to any, flexer autoFlexer) dia
 			diags.Append(expandStruct(ctx, sourcePath, from, targetPath, to, flexer)...)
 			return diags
 		}
+
+		// Top-level struct to union conversion.
+		if typFrom, typTo := valFrom.Type(), valTo.Type(); typFrom.Kind() == reflect.Struct && typTo.Kind() == reflect.Interface {
+			if _, ok := lookupUnionType(typTo); ok {
+				tflog.SubsystemInfo(ctx, subsystemName, "Converting")
+				diags.Append(expandStruct(ctx, sourcePath, from, targetPath, to, flexer)...)
+				return diags
+			}
+		}
 	}
 
 	// Anything else.
@@ -1182,6 +1191,11 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 	}
 
 	if valTo.Kind() == reflect.Interface {
+		if union, ok := lookupUnionType(valTo.Type()); ok && valFrom.Kind() == reflect.Struct {
+			diags.Append(expandUnion(ctx, sourcePath, valFrom, targetPath, valTo, union, flexer)...)
+			return diags
+		}
+
 		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
 			"from": valFrom.Type(),
 			"to":   valTo.Kind(),
@@ -1191,6 +1205,7 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 
 	typeFrom := valFrom.Type()
 	typeTo := valTo.Type()
+	mappings := newFieldMappings(flexer.getOptions())
 
 	for fromField := range expandSourceFields(ctx, typeFrom, flexer.getOptions()) {
 		fromFieldName := fromField.Name
@@ -1202,9 +1217,11 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
 				logAttrKeySourceFieldname: fromFieldName,
 			})
+			mappings.sourceUnmatched(fromField)
 			continue
 		}
 		toFieldName := toField.Name
+		mappings.targetMatched(toFieldName)
 		toFieldVal := valTo.FieldByIndex(toField.Index)
 		if !toFieldVal.CanSet() {
 			// Corresponding field value can't be changed.
@@ -1227,10 +1244,12 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 
 		diags.Append(flexer.convert(ctx, sourcePath.AtName(fromFieldName), valFrom.FieldByIndex(fromField.Index), targetPath.AtName(toFieldName), toFieldVal, opts)...)
 		if diags.HasError() {
-			break
+			return diags
 		}
 	}
 
+	diags.Append(mappings.diagnostics(ctx, sourcePath, typeFrom, targetPath, typeTo, isExpandTargetField(flexer.getOptions()))...)
+
 	return diags
 }
 
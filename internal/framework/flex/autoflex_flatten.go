@@ -193,7 +193,7 @@ func (flattener autoFlattener) convert(ctx context.Context, sourcePath path.Path
 		return diags
 
 	case reflect.Interface:
-		diags.Append(flattener.interface_(ctx, vFrom, tTo, vTo, fieldOpts)...)
+		diags.Append(flattener.interface_(ctx, sourcePath, vFrom, targetPath, tTo, vTo, fieldOpts)...)
 		return diags
 	}
 
@@ -612,7 +612,7 @@ func (flattener autoFlattener) pointer(ctx context.Context, sourcePath path.Path
 	return diags
 }
 
-func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value, _ fieldOpts) diag.Diagnostics {
+func (flattener autoFlattener) interface_(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, tTo attr.Type, vTo reflect.Value, _ fieldOpts) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	switch tTo := tTo.(type) {
@@ -653,7 +653,7 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 		//
 		// interface -> types.List(OfObject) or types.Object.
 		//
-		diags.Append(flattener.interfaceToNestedObject(ctx, vFrom, vFrom.IsNil(), tTo, vTo)...)
+		diags.Append(flattener.interfaceToNestedObject(ctx, sourcePath, vFrom, vFrom.IsNil(), targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -1215,7 +1215,7 @@ func (flattener autoFlattener) structToNestedObject(ctx context.Context, sourceP
 }
 
 // interfaceToNestedObject copies an AWS API interface value to a compatible Plugin Framework NestedObjectValue value.
-func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, isNullFrom bool, targetPath path.Path, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if isNullFrom {
@@ -1237,6 +1237,25 @@ func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFro
 
 	toFlattener, ok := to.(Flattener)
 	if !ok {
+		// Registered union member -> types.List(OfObject) or types.Object.
+		if vElem := vFrom.Elem(); vElem.Kind() == reflect.Pointer && !vElem.IsNil() {
+			if _, ok := lookupUnionMember(vElem.Type().Elem()); ok {
+				diags.Append(flattenStruct(ctx, sourcePath, vElem.Interface(), targetPath, to, flattener)...)
+				if diags.HasError() {
+					return diags
+				}
+
+				val, d := tTo.ValueFromObjectPtr(ctx, to)
+				diags.Append(d...)
+				if diags.HasError() {
+					return diags
+				}
+
+				vTo.Set(reflect.ValueOf(val))
+				return diags
+			}
+		}
+
 		val, d := tTo.NullValue(ctx)
 		diags.Append(d...)
 		if diags.HasError() {
@@ -1722,6 +1741,11 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 		return diags
 	}
 
+	if member, ok := lookupUnionMember(valFrom.Type()); ok {
+		diags.Append(flattenUnionMember(ctx, sourcePath, valFrom, targetPath, valTo, member, flexer)...)
+		return diags
+	}
+
 	typeFrom := valFrom.Type()
 	typeTo := valTo.Type()
 
@@ -1764,6 +1788,8 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 		}
 	}
 
+	mappings := newFieldMappings(flexer.getOptions())
+
 	for fromField := range flattenSourceFields(ctx, typeFrom, flexer.getOptions()) {
 		fromFieldName := fromField.Name
 
@@ -1773,9 +1799,11 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
 				logAttrKeySourceFieldname: fromFieldName,
 			})
+			mappings.sourceUnmatched(fromField)
 			continue
 		}
 		toFieldName := toField.Name
+		mappings.targetMatched(toFieldName)
 		toNameOverride, toFieldOpts := autoflexTags(toField)
 		toFieldVal := valTo.FieldByIndex(toField.Index)
 		if toNameOverride == "-" {
@@ -1843,10 +1871,12 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 
 		diags.Append(flexer.convert(ctx, sourcePath.AtName(fromFieldName), valFrom.FieldByIndex(fromField.Index), targetPath.AtName(toFieldName), toFieldVal, opts)...)
 		if diags.HasError() {
-			break
+			return diags
 		}
 	}
 
+	diags.Append(mappings.diagnostics(ctx, sourcePath, typeFrom, targetPath, typeTo, isFlattenTargetField(flexer.getOptions()))...)
+
 	return diags
 }
 
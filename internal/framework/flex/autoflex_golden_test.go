@@ -11,9 +11,14 @@ package flex
 // For the whole file:
 //   cd internal/framework/flex
 //   go test -v -update-golden .
+//
+// This file also contains the golden tests for AutoFlex's handling of registered AWS SDK for Go v2
+// union (oneOf) types. Their snapshots can be found in
+// testdata/autoflex/golden/expand_union/*.golden and testdata/autoflex/golden/flatten_union/*.golden
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"flag"
 	"fmt"
@@ -25,6 +30,9 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 )
 
 var updateGolden = flag.Bool("update-golden", false, "update golden files")
@@ -225,3 +233,270 @@ func camelToSnake(s string) string {
 	snake := re.ReplaceAllString(s, `${1}_${2}`)
 	return strings.ToLower(snake)
 }
+
+type tfUnion struct {
+	StringValue types.String                                         `tfsdk:"string_value"`
+	ObjectValue fwtypes.ListNestedObjectValueOf[tfSingleStringField] `tfsdk:"object_value"`
+}
+
+type awsUnionSingle struct {
+	Field1 awsUnion
+}
+
+type awsUnionSlice struct {
+	Field1 []awsUnion
+}
+
+type awsUnion interface {
+	isAWSUnion()
+}
+
+type awsUnionMemberStringValue struct {
+	Value string
+}
+
+func (*awsUnionMemberStringValue) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUnionMemberObjectValue struct {
+	Value awsSingleStringValue
+}
+
+func (*awsUnionMemberObjectValue) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+func init() {
+	RegisterUnion[awsUnion](
+		&awsUnionMemberStringValue{},
+		&awsUnionMemberObjectValue{},
+	)
+}
+
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var targetUnion awsUnion
+
+	testCases := autoFlexTestCases{
+		"top level string member": {
+			Source: tfUnion{
+				StringValue: types.StringValue("value1"),
+				ObjectValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+			},
+			Target: &targetUnion,
+			WantTarget: testFlexAWSUnionPtr(&awsUnionMemberStringValue{
+				Value: "value1",
+			}),
+		},
+		"single list Source and string member Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringValue("value1"),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberStringValue{
+					Value: "value1",
+				},
+			},
+		},
+		"single list Source and object member Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringNull(),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value1"),
+							},
+						}),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberObjectValue{
+					Value: awsSingleStringValue{
+						Field1: "value1",
+					},
+				},
+			},
+		},
+		"single list Source with no member set": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringNull(),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: nil,
+			},
+		},
+		"single list Source with multiple members set": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringValue("value1"),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value2"),
+							},
+						}),
+					},
+				}),
+			},
+			Target:        &awsUnionSingle{},
+			ExpectedDiags: diagAFMultipleUnionMembers("string_value", "object_value"),
+		},
+		"non-empty list Source and slice of union Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringValue("value1"),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+					{
+						StringValue: types.StringNull(),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value2"),
+							},
+						}),
+					},
+				}),
+			},
+			Target: &awsUnionSlice{},
+			WantTarget: &awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberStringValue{
+						Value: "value1",
+					},
+					&awsUnionMemberObjectValue{
+						Value: awsSingleStringValue{
+							Field1: "value2",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases, runChecks{CompareDiags: true, CompareTarget: true, GoldenLogs: true})
+}
+
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"top level string member": {
+			Source: &awsUnionMemberStringValue{
+				Value: "value1",
+			},
+			Target: &tfUnion{},
+			WantTarget: &tfUnion{
+				StringValue: types.StringValue("value1"),
+				ObjectValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+			},
+		},
+		"nil union Source and list Target": {
+			Source: awsUnionSingle{
+				Field1: nil,
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+		},
+		"string member Source and single list Target": {
+			Source: awsUnionSingle{
+				Field1: &awsUnionMemberStringValue{
+					Value: "value1",
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringValue("value1"),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+				}),
+			},
+		},
+		"object member Source and single list Target": {
+			Source: awsUnionSingle{
+				Field1: &awsUnionMemberObjectValue{
+					Value: awsSingleStringValue{
+						Field1: "value1",
+					},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringNull(),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value1"),
+							},
+						}),
+					},
+				}),
+			},
+		},
+		"slice of union Source and non-empty list Target": {
+			Source: awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberStringValue{
+						Value: "value1",
+					},
+					&awsUnionMemberObjectValue{
+						Value: awsSingleStringValue{
+							Field1: "value2",
+						},
+					},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						StringValue: types.StringValue("value1"),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+					{
+						StringValue: types.StringNull(),
+						ObjectValue: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value2"),
+							},
+						}),
+					},
+				}),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases, runChecks{CompareDiags: true, CompareTarget: true, GoldenLogs: true})
+}
+
+func testFlexAWSUnionPtr(v awsUnion) *awsUnion { // nosemgrep:ci.aws-in-func-name
+	return &v
+}
+
+func diagAFMultipleUnionMembers(attributeName1, attributeName2 string) diag.Diagnostics {
+	return diag.Diagnostics{
+		diagExpandingMultipleUnionMembers(attributeName1, attributeName2),
+	}
+}
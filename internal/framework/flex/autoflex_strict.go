@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfreflect "github.com/hashicorp/terraform-provider-aws/internal/reflect"
+)
+
+const (
+	// resultMetadataFieldName is the name of the middleware metadata field on AWS API output structures.
+	resultMetadataFieldName = "ResultMetadata"
+)
+
+// frameworkOnlyAttributeNames are the Terraform attribute names of model fields that are
+// managed by the provider framework and have no AWS API counterpart.
+var frameworkOnlyAttributeNames = []string{
+	"id",
+	"region",
+	"tags_all",
+	"timeouts",
+}
+
+// isFrameworkOnlyField returns whether a Terraform model field is managed by the provider framework.
+func isFrameworkOnlyField(field reflect.StructField) bool {
+	name, _, _ := strings.Cut(field.Tag.Get("tfsdk"), ",")
+	return slices.Contains(frameworkOnlyAttributeNames, name)
+}
+
+// fieldMappings tracks the fields matched during a single struct-to-struct conversion
+// so that unmapped fields can be reported in strict mode.
+type fieldMappings struct {
+	enabled         bool
+	unmatchedSource []string
+	matchedTarget   map[string]struct{}
+}
+
+func newFieldMappings(opts AutoFlexOptions) *fieldMappings {
+	return &fieldMappings{
+		enabled:       opts.strict,
+		matchedTarget: make(map[string]struct{}),
+	}
+}
+
+// sourceUnmatched records a source field with no corresponding target field.
+func (m *fieldMappings) sourceUnmatched(field reflect.StructField) {
+	if !m.enabled || field.Name == resultMetadataFieldName || isFrameworkOnlyField(field) {
+		return
+	}
+	m.unmatchedSource = append(m.unmatchedSource, field.Name)
+}
+
+// targetMatched records a target field with a corresponding source field.
+func (m *fieldMappings) targetMatched(fieldName string) {
+	if !m.enabled {
+		return
+	}
+	m.matchedTarget[fieldName] = struct{}{}
+}
+
+// diagnostics returns a warning diagnostic listing every unmatched source field and
+// every target field for which `isTargetField` returns true that was not matched.
+func (m *fieldMappings) diagnostics(ctx context.Context, sourcePath path.Path, typeFrom reflect.Type, targetPath path.Path, typeTo reflect.Type, isTargetField func(reflect.StructField) bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !m.enabled {
+		return diags
+	}
+
+	var unmatchedTarget []string
+	for field := range tfreflect.ExportedStructFields(typeTo) {
+		if _, ok := m.matchedTarget[field.Name]; ok {
+			continue
+		}
+		if !isTargetField(field) {
+			continue
+		}
+		unmatchedTarget = append(unmatchedTarget, field.Name)
+	}
+
+	if len(m.unmatchedSource) == 0 && len(unmatchedTarget) == 0 {
+		return diags
+	}
+
+	tflog.SubsystemWarn(ctx, subsystemName, "Unmapped fields", map[string]any{
+		"autoflex.source.unmapped": m.unmatchedSource,
+		"autoflex.target.unmapped": unmatchedTarget,
+	})
+	diags.Append(diagUnmappedFields(sourcePath, typeFrom, m.unmatchedSource, targetPath, typeTo, unmatchedTarget))
+
+	return diags
+}
+
+// isExpandTargetField returns whether an AWS API structure field is expected to be set by expansion.
+func isExpandTargetField(opts AutoFlexOptions) func(reflect.StructField) bool {
+	return func(field reflect.StructField) bool {
+		return !opts.isIgnoredField(field.Name)
+	}
+}
+
+// isFlattenTargetField returns whether a Terraform model field is expected to be set by flattening.
+func isFlattenTargetField(opts AutoFlexOptions) func(reflect.StructField) bool {
+	return func(field reflect.StructField) bool {
+		if opts.isIgnoredField(field.Name) || field.Name == mapBlockKeyFieldName || isFrameworkOnlyField(field) {
+			return false
+		}
+		toNameOverride, toFieldOpts := autoflexTags(field)
+		return toNameOverride != "-" && !toFieldOpts.NoFlatten()
+	}
+}
+
+func diagUnmappedFields(sourcePath path.Path, sourceType reflect.Type, sourceFields []string, targetPath path.Path, targetType reflect.Type, targetFields []string) diag.WarningDiagnostic {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Converting %q (at %q) to %q (at %q).", fullTypeName(sourceType), sourcePath.String(), fullTypeName(targetType), targetPath.String())
+	if len(sourceFields) > 0 {
+		fmt.Fprintf(&sb, "\nSource fields with no corresponding target field: %s", strings.Join(sourceFields, ", "))
+	}
+	if len(targetFields) > 0 {
+		fmt.Fprintf(&sb, "\nTarget fields with no corresponding source field: %s", strings.Join(targetFields, ", "))
+	}
+
+	return diag.NewWarningDiagnostic(
+		"Unmapped Fields",
+		"AutoFlex strict mode found fields that were not mapped between source and target. "+
+			"Unmapped fields are expected for some types, e.g. computed-only or API-only fields, "+
+			"so review each one and add an explicit mapping or option where the field should be converted:\n\n"+
+			sb.String(),
+	)
+}
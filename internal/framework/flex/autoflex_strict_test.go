@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+// Tests AutoFlex strict mode reporting of unmapped fields.
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type tfStrict struct {
+	Field1 types.String `tfsdk:"field1"`
+	Field2 types.String `tfsdk:"field2"`
+	Field3 types.String `tfsdk:"field3" autoflex:"-"`
+}
+
+type awsStrict struct {
+	Field1 *string
+	Field4 *string
+}
+
+type TFStrictRegion struct {
+	Region types.String `tfsdk:"region"`
+}
+
+type tfStrictFrameworkFields struct {
+	TFStrictRegion
+	Field1   types.String `tfsdk:"field1"`
+	ID       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
+type awsStrictOutput struct {
+	Field1         *string
+	Field2         *string
+	ResultMetadata struct{}
+}
+
+func TestExpandStrict(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"not strict": {
+			Source: &tfStrict{
+				Field1: types.StringValue("value1"),
+				Field2: types.StringValue("value2"),
+			},
+			Target: &awsStrict{},
+			WantTarget: &awsStrict{
+				Field1: aws.String("value1"),
+			},
+		},
+		"unmapped fields": {
+			Options: []AutoFlexOptionsFunc{WithStrict()},
+			Source: &tfStrict{
+				Field1: types.StringValue("value1"),
+				Field2: types.StringValue("value2"),
+			},
+			Target: &awsStrict{},
+			ExpectedDiags: diag.Diagnostics{
+				diagUnmappedFields(path.Empty(), reflect.TypeFor[tfStrict](), []string{"Field2"}, path.Empty(), reflect.TypeFor[awsStrict](), []string{"Field4"}),
+			},
+			WantTarget: &awsStrict{
+				Field1: aws.String("value1"),
+			},
+		},
+		"ignored fields": {
+			Options: []AutoFlexOptionsFunc{WithStrict(), WithIgnoredFieldNamesAppend("Field2"), WithIgnoredFieldNamesAppend("Field4")},
+			Source: &tfStrict{
+				Field1: types.StringValue("value1"),
+				Field2: types.StringValue("value2"),
+			},
+			Target: &awsStrict{},
+			WantTarget: &awsStrict{
+				Field1: aws.String("value1"),
+			},
+		},
+		"framework-only fields": {
+			Options: []AutoFlexOptionsFunc{WithStrict(), WithIgnoredFieldNamesAppend("Field4")},
+			Source: &tfStrictFrameworkFields{
+				TFStrictRegion: TFStrictRegion{
+					Region: types.StringValue("us-west-2"), //lintignore:AWSAT003
+				},
+				Field1:   types.StringValue("value1"),
+				ID:       types.StringValue("id1"),
+				Timeouts: types.ObjectNull(nil),
+			},
+			Target: &awsStrict{},
+			WantTarget: &awsStrict{
+				Field1: aws.String("value1"),
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases, runChecks{CompareDiags: true, CompareTarget: true})
+}
+
+func TestFlattenStrict(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"not strict": {
+			Source: &awsStrict{
+				Field1: aws.String("value1"),
+				Field4: aws.String("value4"),
+			},
+			Target: &tfStrict{},
+			WantTarget: &tfStrict{
+				Field1: types.StringValue("value1"),
+			},
+		},
+		"unmapped fields": {
+			Options: []AutoFlexOptionsFunc{WithStrict()},
+			Source: &awsStrict{
+				Field1: aws.String("value1"),
+				Field4: aws.String("value4"),
+			},
+			Target: &tfStrict{},
+			ExpectedDiags: diag.Diagnostics{
+				diagUnmappedFields(path.Empty(), reflect.TypeFor[awsStrict](), []string{"Field4"}, path.Empty(), reflect.TypeFor[tfStrict](), []string{"Field2"}),
+			},
+			WantTarget: &tfStrict{
+				Field1: types.StringValue("value1"),
+			},
+		},
+		"result metadata": {
+			Options: []AutoFlexOptionsFunc{WithStrict()},
+			Source: &awsStrictOutput{
+				Field1: aws.String("value1"),
+				Field2: aws.String("value2"),
+			},
+			Target: &tfStrict{},
+			WantTarget: &tfStrict{
+				Field1: types.StringValue("value1"),
+				Field2: types.StringValue("value2"),
+			},
+		},
+		"framework-only fields": {
+			Options: []AutoFlexOptionsFunc{WithStrict(), WithIgnoredFieldNamesAppend("Field4")},
+			Source: &awsStrict{
+				Field1: aws.String("value1"),
+				Field4: aws.String("value4"),
+			},
+			Target: &tfStrictFrameworkFields{},
+			WantTarget: &tfStrictFrameworkFields{
+				Field1: types.StringValue("value1"),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases, runChecks{CompareDiags: true, CompareTarget: true})
+}
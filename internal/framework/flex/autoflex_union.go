@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// AWS SDK for Go v2 models union ("oneOf") shapes as an interface type with one
+// struct type per member, e.g.
+//
+//	type Credential interface { isCredential() }
+//	type CredentialMemberApiKeyCredential struct { Value ApiKeyCredential }
+//	type CredentialMemberOauth2Credential struct { Value Oauth2Credential }
+//
+// The Terraform representation of such a shape is a nested block with one
+// child block (or attribute) per member, at most one of which is set.
+// Registering the union's members allows AutoFlex to expand and flatten
+// between the two representations without a hand-written Expander/Flattener.
+
+const (
+	unionMemberNameSeparator = "Member"
+	unionMemberValueField    = "Value"
+)
+
+// unionType describes a registered AWS SDK for Go v2 union interface type.
+type unionType struct {
+	// interfaceType is the union's interface type.
+	interfaceType reflect.Type
+	// members maps member names to member struct types.
+	members map[string]reflect.Type
+	// memberNames is a synthetic struct type with one field per member, used for fuzzy field name matching.
+	memberNames reflect.Type
+}
+
+// unionMember describes a single member of a registered union.
+type unionMember struct {
+	name  string
+	union *unionType
+}
+
+var (
+	unionRegistryLock sync.RWMutex
+	// unionTypes maps union interface types to their descriptions.
+	unionTypes = make(map[reflect.Type]*unionType)
+	// unionMembers maps union member struct types to their descriptions.
+	unionMembers = make(map[reflect.Type]unionMember)
+)
+
+// RegisterUnion registers the member types of the AWS SDK for Go v2 union interface type T.
+// Each member must be a pointer to a struct with a single field named `Value`.
+// The member name is the portion of the struct's type name following "<T>Member",
+// e.g. "ApiKeyCredential" for `CredentialMemberApiKeyCredential`, and is matched
+// against source (expand) or target (flatten) field names in the same way as struct fields.
+//
+// RegisterUnion panics if T is not an interface type or a member is not well-formed.
+// It is intended to be called from a service package's `init` function.
+func RegisterUnion[T any](members ...T) {
+	tInterface := reflect.TypeFor[T]()
+	if tInterface.Kind() != reflect.Interface {
+		panic(fmt.Sprintf("autoflex: union type %s is not an interface", fullTypeName(tInterface)))
+	}
+
+	union := &unionType{
+		interfaceType: tInterface,
+		members:       make(map[string]reflect.Type, len(members)),
+	}
+	fields := make([]reflect.StructField, 0, len(members))
+
+	for _, member := range members {
+		tMember := reflect.TypeOf(member)
+		if tMember == nil || tMember.Kind() != reflect.Pointer || tMember.Elem().Kind() != reflect.Struct {
+			panic(fmt.Sprintf("autoflex: union %s member %s is not a pointer to struct", fullTypeName(tInterface), fullTypeName(tMember)))
+		}
+		tMember = tMember.Elem()
+
+		field, ok := tMember.FieldByName(unionMemberValueField)
+		if !ok {
+			panic(fmt.Sprintf("autoflex: union %s member %s has no %s field", fullTypeName(tInterface), fullTypeName(tMember), unionMemberValueField))
+		}
+
+		prefix := tInterface.Name() + unionMemberNameSeparator
+		name, ok := strings.CutPrefix(tMember.Name(), prefix)
+		if !ok || name == "" {
+			panic(fmt.Sprintf("autoflex: union %s member %s name does not start with %q", fullTypeName(tInterface), fullTypeName(tMember), prefix))
+		}
+
+		union.members[name] = tMember
+		fields = append(fields, reflect.StructField{
+			Name: name,
+			Type: field.Type,
+		})
+	}
+
+	union.memberNames = reflect.StructOf(fields)
+
+	unionRegistryLock.Lock()
+	defer unionRegistryLock.Unlock()
+
+	unionTypes[tInterface] = union
+	for name, tMember := range union.members {
+		unionMembers[tMember] = unionMember{
+			name:  name,
+			union: union,
+		}
+	}
+}
+
+// lookupUnionType returns the registered union for the specified interface type.
+func lookupUnionType(t reflect.Type) (*unionType, bool) {
+	unionRegistryLock.RLock()
+	defer unionRegistryLock.RUnlock()
+
+	union, ok := unionTypes[t]
+	return union, ok
+}
+
+// lookupUnionMember returns the registered union member for the specified struct type.
+func lookupUnionMember(t reflect.Type) (unionMember, bool) {
+	unionRegistryLock.RLock()
+	defer unionRegistryLock.RUnlock()
+
+	member, ok := unionMembers[t]
+	return member, ok
+}
+
+// expandUnion expands the single set field of struct `valFrom` into the corresponding member of union `valTo`.
+func expandUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, union *unionType, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.SubsystemInfo(ctx, subsystemName, "Target is a union")
+
+	typeFrom := valFrom.Type()
+	var memberName, fromMemberFieldName string
+
+	for fromField := range expandSourceFields(ctx, typeFrom, flexer.getOptions()) {
+		fromFieldName := fromField.Name
+
+		toField, ok := (&fuzzyFieldFinder{}).findField(ctx, fromFieldName, typeFrom, union.memberNames, flexer)
+		if !ok {
+			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding union member", map[string]any{
+				logAttrKeySourceFieldname: fromFieldName,
+			})
+			continue
+		}
+
+		fromFieldVal := valFrom.FieldByIndex(fromField.Index)
+		if v, ok := fromFieldVal.Interface().(attr.Value); ok && (v.IsNull() || v.IsUnknown()) {
+			continue
+		}
+
+		if memberName != "" {
+			tflog.SubsystemError(ctx, subsystemName, "Multiple union members set", map[string]any{
+				logAttrKeySourceFieldname: fromFieldName,
+			})
+			diags.Append(diagExpandingMultipleUnionMembers(unionMemberAttributeName(typeFrom, fromMemberFieldName), unionMemberAttributeName(typeFrom, fromFieldName)))
+			return diags
+		}
+		memberName, fromMemberFieldName = toField.Name, fromFieldName
+
+		tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+			logAttrKeySourceFieldname: fromFieldName,
+			logAttrKeyTargetFieldname: memberName,
+		})
+
+		member := reflect.New(union.members[memberName])
+		diags.Append(flexer.convert(ctx, sourcePath.AtName(fromFieldName), fromFieldVal, targetPath.AtName(memberName), member.Elem().FieldByName(unionMemberValueField), fieldOpts{})...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if !member.Type().Implements(valTo.Type()) {
+			diags.Append(diagExpandedTypeDoesNotImplement(member.Type(), valTo.Type()))
+			return diags
+		}
+
+		valTo.Set(member)
+	}
+
+	if memberName == "" {
+		tflog.SubsystemTrace(ctx, subsystemName, "No union member set")
+	}
+
+	return diags
+}
+
+// flattenUnionMember flattens the value of union member `valFrom` into the corresponding field of struct `valTo`.
+// All other nested object fields of `valTo` are set to null.
+func flattenUnionMember(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, member unionMember, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.SubsystemInfo(ctx, subsystemName, "Source is a union member")
+
+	diags.Append(flattenPrePopulate(ctx, valTo)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	typeTo := valTo.Type()
+
+	toField, ok := (&fuzzyFieldFinder{}).findField(ctx, member.name, member.union.memberNames, typeTo, flexer)
+	if !ok {
+		tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
+			logAttrKeySourceFieldname: member.name,
+		})
+		return diags
+	}
+	toFieldName := toField.Name
+	toFieldVal := valTo.FieldByIndex(toField.Index)
+	if !toFieldVal.CanSet() {
+		tflog.SubsystemDebug(ctx, subsystemName, "Field cannot be set", map[string]any{
+			logAttrKeySourceFieldname: member.name,
+			logAttrKeyTargetFieldname: toFieldName,
+		})
+		return diags
+	}
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+		logAttrKeySourceFieldname: member.name,
+		logAttrKeyTargetFieldname: toFieldName,
+	})
+
+	_, toFieldOpts := autoflexTags(toField)
+	opts := fieldOpts{
+		legacy:    toFieldOpts.Legacy(),
+		omitempty: toFieldOpts.OmitEmpty(),
+	}
+
+	diags.Append(flexer.convert(ctx, sourcePath.AtName(unionMemberValueField), valFrom.FieldByName(unionMemberValueField), targetPath.AtName(toFieldName), toFieldVal, opts)...)
+
+	return diags
+}
+
+func diagExpandingMultipleUnionMembers(attributeName1, attributeName2 string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Combination",
+		fmt.Sprintf("Only one of %q and %q can be configured in the same block, but both are set. "+
+			"Remove one of them from the configuration.", attributeName1, attributeName2),
+	)
+}
+
+// unionMemberAttributeName returns the Terraform attribute name of the named field of struct type `t`,
+// falling back to the field name if the field has no `tfsdk` tag.
+func unionMemberAttributeName(t reflect.Type, fieldName string) string {
+	if field, ok := t.FieldByName(fieldName); ok {
+		if v, _, _ := strings.Cut(field.Tag.Get("tfsdk"), ","); v != "" {
+			return v
+		}
+	}
+
+	return fieldName
+}
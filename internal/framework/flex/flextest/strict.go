@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package flextest contains helpers for testing AutoFlex conversions in service packages.
+package flextest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+// To accept changes to the unmapped fields of a service package's AutoFlex conversions:
+//
+//	go test -run 'TestAutoFlexStrict' -update-golden
+var updateGolden = flag.Bool("update-golden", false, "update AutoFlex strict mode golden files")
+
+// StrictTestCase is a Terraform Plugin Framework model and AWS API structure
+// pair that a resource expands or flattens with AutoFlex.
+type StrictTestCase struct {
+	// Source is the value to be expanded or flattened.
+	// Only non-null nested values are traversed, so populate nested blocks
+	// to check their fields.
+	Source any
+	// Target is a pointer to the value to be expanded or flattened into.
+	Target any
+	// Options are the AutoFlex options that the resource passes.
+	Options []fwflex.AutoFlexOptionsFunc
+}
+
+type StrictTestCases map[string]StrictTestCase
+
+// RunStrictExpandTestCases expands each test case's Source into its Target in strict mode
+// and compares the unmapped fields with the test case's golden file in
+// testdata/autoflex_strict/expand. A test case without unmapped fields has no golden file.
+//
+// Service packages don't call it directly. Instead, add
+//
+//	//go:generate go run ../../generate/autoflexstrict/main.go
+//
+// to the package's generate.go, which writes a test calling it for every
+// fwflex.Expand and fwflex.Flatten call in the package.
+func RunStrictExpandTestCases(t *testing.T, testCases StrictTestCases) {
+	t.Helper()
+
+	runStrictTestCases(t, "expand", testCases, fwflex.Expand)
+}
+
+// RunStrictFlattenTestCases flattens each test case's Source into its Target in strict mode
+// and compares the unmapped fields with the test case's golden file in
+// testdata/autoflex_strict/flatten.
+func RunStrictFlattenTestCases(t *testing.T, testCases StrictTestCases) {
+	t.Helper()
+
+	runStrictTestCases(t, "flatten", testCases, fwflex.Flatten)
+}
+
+func runStrictTestCases(t *testing.T, kind string, testCases StrictTestCases, f func(context.Context, any, any, ...fwflex.AutoFlexOptionsFunc) diag.Diagnostics) {
+	t.Helper()
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			diags := f(ctx, testCase.Source, testCase.Target, append(slices.Clone(testCase.Options), fwflex.WithStrict())...)
+
+			var got []string
+			for _, d := range diags {
+				if d.Severity() != diag.SeverityWarning || d.Summary() != "Unmapped Fields" {
+					t.Errorf("%s: %s\n\n%s", d.Severity(), d.Summary(), d.Detail())
+					continue
+				}
+
+				// Drop the explanation that precedes the unmapped fields.
+				detail := d.Detail()
+				if _, after, ok := strings.Cut(detail, "\n\n"); ok {
+					detail = after
+				}
+				got = append(got, strings.Split(detail, "\n")...)
+			}
+
+			compareWithGolden(t, goldenPath(kind, name), got)
+		})
+	}
+}
+
+func goldenPath(kind, name string) string {
+	return filepath.Join("testdata", "autoflex_strict", kind, regexache.MustCompile(`[^0-9A-Za-z]+`).ReplaceAllString(name, "_")+".golden")
+}
+
+func compareWithGolden(t *testing.T, path string, got []string) {
+	t.Helper()
+
+	if *updateGolden {
+		if len(got) == 0 {
+			if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				t.Fatalf("remove golden file %s: %v", path, err)
+			}
+			return
+		}
+
+		data, err := json.MarshalIndent(got, "", "  ")
+		if err != nil {
+			t.Fatalf("marshal golden data for %s: %v", path, err)
+		}
+
+		dir := filepath.Dir(path)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatalf("create directory %s: %v", dir, err)
+		}
+
+		if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+			t.Fatalf("write golden file %s: %v", path, err)
+		}
+		return
+	}
+
+	var want []string
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+	case err != nil:
+		t.Fatalf("read golden file %s: %v", path, err)
+	default:
+		if err := json.Unmarshal(bytes.TrimSpace(data), &want); err != nil {
+			t.Fatalf("unmarshal golden file %s: %v", path, err)
+		}
+	}
+
+	if slices.Equal(want, got) {
+		return
+	}
+
+	t.Errorf("unmapped fields differ from golden file %s; run with -update-golden to accept the changes\n\nwant:\n%s\n\ngot:\n%s",
+		path, strings.Join(want, "\n"), strings.Join(got, "\n"))
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flextest
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+// withRegionModel mirrors framework.WithRegionModel, which can't be imported here.
+type withRegionModel struct {
+	Region types.String `tfsdk:"region"`
+}
+
+type tfStrict struct {
+	withRegionModel
+	Field1   types.String `tfsdk:"field1"`
+	Field2   types.String `tfsdk:"field2"`
+	Field3   types.String `tfsdk:"field3" autoflex:"-"`
+	ID       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
+type awsStrict struct {
+	Field1 *string
+	Field4 *string
+}
+
+type awsStrictOutput struct {
+	Field1         *string
+	Field2         *string
+	ResultMetadata struct{}
+}
+
+func TestRunStrictTestCases(t *testing.T) {
+	t.Parallel()
+
+	RunStrictExpandTestCases(t, StrictTestCases{
+		"ignored fields": {
+			Source:  tfStrict{},
+			Target:  &awsStrict{},
+			Options: []fwflex.AutoFlexOptionsFunc{fwflex.WithIgnoredFieldNamesAppend("Field2"), fwflex.WithIgnoredFieldNamesAppend("Field4")},
+		},
+		"unmapped fields": {
+			Source: tfStrict{},
+			Target: &awsStrict{},
+		},
+	})
+
+	RunStrictFlattenTestCases(t, StrictTestCases{
+		"mapped fields": {
+			Source: awsStrictOutput{},
+			Target: &tfStrict{},
+		},
+	})
+}
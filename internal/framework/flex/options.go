@@ -27,6 +27,10 @@ type AutoFlexOptions struct {
 	// ignoredFieldNames stores names which expanders and flatteners will
 	// not read from or write to
 	ignoredFieldNames []string
+
+	// strict specifies that unmapped source and target fields are reported
+	// as warning diagnostics
+	strict bool
 }
 
 // WithFieldNamePrefix specifies a prefix to be accounted for when
@@ -77,6 +81,17 @@ func WithNoIgnoredFieldNames() AutoFlexOptionsFunc {
 	}
 }
 
+// WithStrict reports, as a warning diagnostic, every source field with no
+// corresponding target field and every target field with no corresponding
+// source field
+//
+// Use this option in tests to detect attributes that AutoFlex silently skips.
+func WithStrict() AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		o.strict = true
+	}
+}
+
 // isIgnoredField returns true if s is in the list of ignored field names
 func (o *AutoFlexOptions) isIgnoredField(s string) bool {
 	return slices.Contains(o.ignoredFieldNames, s)
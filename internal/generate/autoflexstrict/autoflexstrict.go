@@ -0,0 +1,1010 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package autoflexstrict finds every fwflex.Expand and fwflex.Flatten call in a service package whose
+// source and target types can be determined from the package's source code, for generating a test
+// that runs each pair through AutoFlex in strict mode.
+package autoflexstrict
+
+import (
+	"cmp"
+	_ "embed"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"maps"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	flexImportPath = "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	sdkPrefix      = "github.com/aws/aws-sdk-go-v2/service/"
+	sdkTypesSuffix = "/types"
+)
+
+// Template is the template of the generated test file.
+//
+//go:embed file.gtpl
+var Template string
+
+type TemplateData struct {
+	PackageName string
+	Imports     []Import
+	Expand      []TestCase
+	Flatten     []TestCase
+}
+
+type Import struct {
+	Alias string
+	Path  string
+}
+
+type TestCase struct {
+	Name    string
+	Source  string
+	Target  string
+	Options []string
+}
+
+// Find parses the service package in dir and returns the template data for its AutoFlex strict mode test
+// and a description of each Expand or Flatten call that was skipped because its types could not be resolved.
+// If no calls were resolved, the template data has no test cases.
+func Find(dir, packageName string) (TemplateData, []string, error) {
+	l := &loader{
+		packages: make(map[string]*packageInfo),
+	}
+	pkg, err := l.parseDir(dir, "")
+	if err != nil {
+		return TemplateData{}, nil, fmt.Errorf("parsing package: %w", err)
+	}
+
+	v := &visitor{
+		loader: l,
+		pkg:    pkg,
+	}
+	v.visitPackage()
+
+	td, err := newTemplateData(packageName, v.cases)
+	if err != nil {
+		return TemplateData{}, nil, err
+	}
+
+	return td, v.unresolved, nil
+}
+
+func newTemplateData(packageName string, cases []strictCase) (TemplateData, error) {
+	td := TemplateData{
+		PackageName: packageName,
+	}
+	imports := &importRegistry{
+		aliases: map[string]string{
+			"testing":  "testing",
+			"fwflex":   flexImportPath,
+			"flextest": flexImportPath + "/flextest",
+		},
+	}
+
+	seen := make(map[string]int)
+	for _, c := range cases {
+		source, err := imports.render(c.source)
+		if err != nil {
+			return td, fmt.Errorf("%s: %w", c.position, err)
+		}
+		target, err := imports.render(c.target)
+		if err != nil {
+			return td, fmt.Errorf("%s: %w", c.position, err)
+		}
+
+		tc := TestCase{
+			Source:  source,
+			Target:  target,
+			Options: c.options,
+		}
+
+		key := strings.Join(append([]string{c.function, tc.Source, tc.Target}, tc.Options...), "|")
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		name := fmt.Sprintf("%s to %s", typeName(tc.Source), typeName(tc.Target))
+		seen[key] = 0
+		seen[name]++
+		if n := seen[name]; n > 1 {
+			name = fmt.Sprintf("%s (%d)", name, n)
+		}
+		tc.Name = name
+
+		switch c.function {
+		case "Expand":
+			td.Expand = append(td.Expand, tc)
+		case "Flatten":
+			td.Flatten = append(td.Flatten, tc)
+		}
+	}
+
+	for alias, path := range imports.aliases {
+		switch alias {
+		case "testing":
+			continue
+		case "fwflex":
+			if !slices.ContainsFunc(slices.Concat(td.Expand, td.Flatten), func(tc TestCase) bool { return len(tc.Options) > 0 }) {
+				continue
+			}
+		}
+		if alias == importName(path) {
+			alias = ""
+		}
+		td.Imports = append(td.Imports, Import{Alias: alias, Path: path})
+	}
+	slices.SortFunc(td.Imports, func(a, b Import) int {
+		return cmp.Compare(a.Path, b.Path)
+	})
+	for _, v := range [][]TestCase{td.Expand, td.Flatten} {
+		slices.SortFunc(v, func(a, b TestCase) int {
+			return cmp.Compare(a.Name, b.Name)
+		})
+	}
+
+	return td, nil
+}
+
+// typeName returns the name of the type of a rendered zero value.
+func typeName(value string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(value, "&"), "{}")
+}
+
+// importRegistry assigns an import alias to each package referenced by the generated file.
+type importRegistry struct {
+	aliases map[string]string // Alias to import path.
+}
+
+func (r *importRegistry) alias(importPath, preferred string) string {
+	for alias, path := range r.aliases {
+		if path == importPath {
+			return alias
+		}
+	}
+
+	alias := preferred
+	for i := 2; ; i++ {
+		if _, ok := r.aliases[alias]; !ok {
+			break
+		}
+		alias = preferred + strconv.Itoa(i)
+	}
+	r.aliases[alias] = importPath
+
+	return alias
+}
+
+// render returns the Go source for a zero value of the given type, or a pointer to one.
+func (r *importRegistry) render(t typ) (string, error) {
+	var prefix string
+	if expr, ok := t.expr.(*ast.StarExpr); ok {
+		prefix = "&"
+		t = typ{expr: expr.X, file: t.file}
+	}
+
+	switch expr := t.expr.(type) {
+	case *ast.Ident:
+		if t.file.pkg.path == "" {
+			return prefix + expr.Name + "{}", nil
+		}
+		return fmt.Sprintf("%s%s.%s{}", prefix, r.alias(t.file.pkg.path, preferredAlias(t.file.pkg.path, t.file.pkg.name)), expr.Name), nil
+	case *ast.SelectorExpr:
+		x := expr.X.(*ast.Ident)
+		importPath := t.file.imports[x.Name]
+		return fmt.Sprintf("%s%s.%s{}", prefix, r.alias(importPath, preferredAlias(importPath, x.Name)), expr.Sel.Name), nil
+	}
+
+	return "", fmt.Errorf("unsupported type %T", t.expr)
+}
+
+func preferredAlias(importPath, name string) string {
+	if strings.HasPrefix(importPath, sdkPrefix) && strings.HasSuffix(importPath, sdkTypesSuffix) {
+		return "awstypes"
+	}
+	return name
+}
+
+// strictCase is a fwflex.Expand or fwflex.Flatten call whose source and target types are known.
+type strictCase struct {
+	position string
+	function string
+	source   typ // A named struct type, or a pointer to one.
+	target   typ // A named struct type.
+	options  []string
+}
+
+// typ is a type expression and the file in which it appears.
+type typ struct {
+	expr ast.Expr
+	file *fileInfo
+}
+
+func (t typ) pointer() typ {
+	return typ{expr: &ast.StarExpr{X: t.expr}, file: t.file}
+}
+
+func (t typ) deref() (typ, bool) {
+	if expr, ok := t.expr.(*ast.StarExpr); ok {
+		return typ{expr: expr.X, file: t.file}, true
+	}
+	return t, false
+}
+
+type packageInfo struct {
+	path  string // Empty for the service package.
+	name  string
+	types map[string]typeDecl
+	funcs map[string]funcDecl // Keyed by name for functions and by "Type.Name" for methods.
+	files []*fileInfo
+}
+
+type fileInfo struct {
+	pkg     *packageInfo
+	name    string
+	file    *ast.File
+	imports map[string]string // Alias to import path.
+}
+
+type typeDecl struct {
+	spec *ast.TypeSpec
+	file *fileInfo
+}
+
+type funcDecl struct {
+	decl *ast.FuncDecl
+	file *fileInfo
+}
+
+// loader parses the source of the service package and the packages it references.
+type loader struct {
+	fset     *token.FileSet
+	packages map[string]*packageInfo
+}
+
+func (l *loader) parseDir(dir, importPath string) (*packageInfo, error) {
+	if l.fset == nil {
+		l.fset = token.NewFileSet()
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg := &packageInfo{
+		path:  importPath,
+		types: make(map[string]typeDecl),
+		funcs: make(map[string]funcDecl),
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(l.fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		if pkg.name == "" {
+			pkg.name = file.Name.Name
+		}
+
+		fi := &fileInfo{
+			pkg:     pkg,
+			name:    name,
+			file:    file,
+			imports: make(map[string]string),
+		}
+		for _, spec := range file.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			alias := importName(path)
+			if spec.Name != nil {
+				alias = spec.Name.Name
+			}
+			fi.imports[alias] = path
+		}
+		pkg.files = append(pkg.files, fi)
+
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				if decl.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range decl.Specs {
+					spec := spec.(*ast.TypeSpec)
+					pkg.types[spec.Name.Name] = typeDecl{spec: spec, file: fi}
+				}
+			case *ast.FuncDecl:
+				key := decl.Name.Name
+				if decl.Recv != nil && len(decl.Recv.List) == 1 {
+					if name, ok := receiverTypeName(decl.Recv.List[0].Type); ok {
+						key = name + "." + key
+					}
+				}
+				pkg.funcs[key] = funcDecl{decl: decl, file: fi}
+			}
+		}
+	}
+
+	return pkg, nil
+}
+
+// load returns the parsed package with the given import path, or nil if its source can't be found.
+func (l *loader) load(importPath string) *packageInfo {
+	if pkg, ok := l.packages[importPath]; ok {
+		return pkg
+	}
+	l.packages[importPath] = nil
+
+	output, err := exec.Command("go", "list", "-f", "{{.Dir}}", importPath).Output()
+	if err != nil {
+		return nil
+	}
+	pkg, err := l.parseDir(strings.TrimSpace(string(output)), importPath)
+	if err != nil {
+		return nil
+	}
+	l.packages[importPath] = pkg
+
+	return pkg
+}
+
+func importName(importPath string) string {
+	name := path.Base(importPath)
+	if strings.HasPrefix(name, "v") {
+		if _, err := strconv.Atoi(name[1:]); err == nil {
+			name = path.Base(path.Dir(importPath))
+		}
+	}
+	return name
+}
+
+func receiverTypeName(expr ast.Expr) (string, bool) {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return receiverTypeName(expr.X)
+	case *ast.Ident:
+		return expr.Name, true
+	case *ast.IndexExpr:
+		return receiverTypeName(expr.X)
+	case *ast.IndexListExpr:
+		return receiverTypeName(expr.X)
+	}
+	return "", false
+}
+
+// named returns the declaration of a named type, following aliases and pointers are not dereferenced.
+func (l *loader) named(t typ) (typeDecl, bool) {
+	var decl typeDecl
+	switch expr := t.expr.(type) {
+	case *ast.ParenExpr:
+		return l.named(typ{expr: expr.X, file: t.file})
+	case *ast.Ident:
+		v, ok := t.file.pkg.types[expr.Name]
+		if !ok {
+			return decl, false
+		}
+		decl = v
+	case *ast.SelectorExpr:
+		x, ok := expr.X.(*ast.Ident)
+		if !ok {
+			return decl, false
+		}
+		importPath, ok := t.file.imports[x.Name]
+		if !ok {
+			return decl, false
+		}
+		pkg := l.load(importPath)
+		if pkg == nil {
+			return decl, false
+		}
+		v, ok := pkg.types[expr.Sel.Name]
+		if !ok {
+			return decl, false
+		}
+		decl = v
+	default:
+		return decl, false
+	}
+
+	if decl.spec.TypeParams != nil {
+		return decl, false
+	}
+
+	return decl, true
+}
+
+// underlying returns the type literal underlying a named type.
+func (l *loader) underlying(t typ) (typ, bool) {
+	for range 10 {
+		decl, ok := l.named(t)
+		if !ok {
+			break
+		}
+		t = typ{expr: decl.spec.Type, file: decl.file}
+	}
+
+	switch t.expr.(type) {
+	case *ast.StructType, *ast.ArrayType, *ast.MapType, *ast.StarExpr:
+		return t, true
+	}
+
+	return t, false
+}
+
+// isNamedStruct returns whether a type is a named, non-generic struct type.
+func (l *loader) isNamedStruct(t typ) bool {
+	if _, ok := l.named(t); !ok {
+		return false
+	}
+	u, ok := l.underlying(t)
+	if !ok {
+		return false
+	}
+	_, ok = u.expr.(*ast.StructType)
+	return ok
+}
+
+// field returns the type of a struct field, including promoted fields of embedded structs.
+func (l *loader) field(t typ, name string) (typ, bool) {
+	return l.fieldDepth(t, name, 0)
+}
+
+func (l *loader) fieldDepth(t typ, name string, depth int) (typ, bool) {
+	if depth > 5 {
+		return typ{}, false
+	}
+
+	t, _ = t.deref()
+	u, ok := l.underlying(t)
+	if !ok {
+		return typ{}, false
+	}
+	st, ok := u.expr.(*ast.StructType)
+	if !ok {
+		return typ{}, false
+	}
+
+	for _, field := range st.Fields.List {
+		for _, ident := range field.Names {
+			if ident.Name == name {
+				return typ{expr: field.Type, file: u.file}, true
+			}
+		}
+	}
+
+	for _, field := range st.Fields.List {
+		if len(field.Names) > 0 {
+			continue
+		}
+		embedded := typ{expr: field.Type, file: u.file}
+		if embeddedName(field.Type) == name {
+			return embedded, true
+		}
+		if v, ok := l.fieldDepth(embedded, name, depth+1); ok {
+			return v, true
+		}
+	}
+
+	return typ{}, false
+}
+
+func embeddedName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return embeddedName(expr.X)
+	case *ast.Ident:
+		return expr.Name
+	case *ast.SelectorExpr:
+		return expr.Sel.Name
+	}
+	return ""
+}
+
+// elem returns the element type of a slice, array or map type.
+func (l *loader) elem(t typ) (typ, bool) {
+	u, ok := l.underlying(t)
+	if !ok {
+		return typ{}, false
+	}
+	switch expr := u.expr.(type) {
+	case *ast.ArrayType:
+		return typ{expr: expr.Elt, file: u.file}, true
+	case *ast.MapType:
+		return typ{expr: expr.Value, file: u.file}, true
+	}
+	return typ{}, false
+}
+
+// results returns the result types of a function declaration.
+func results(fd funcDecl) []typ {
+	var types []typ
+	if fd.decl.Type.TypeParams != nil || fd.decl.Type.Results == nil {
+		return types
+	}
+	for _, field := range fd.decl.Type.Results.List {
+		n := max(len(field.Names), 1)
+		for range n {
+			types = append(types, typ{expr: field.Type, file: fd.file})
+		}
+	}
+	return types
+}
+
+// scope maps variable names to their types. A nil type expression means the variable's type is unknown.
+type scope struct {
+	parent *scope
+	vars   map[string]typ
+}
+
+func newScope(parent *scope) *scope {
+	return &scope{
+		parent: parent,
+		vars:   make(map[string]typ),
+	}
+}
+
+func (s *scope) declare(name string, t typ) {
+	if name == "_" {
+		return
+	}
+	s.vars[name] = t
+}
+
+func (s *scope) lookup(name string) (typ, bool) {
+	for s := s; s != nil; s = s.parent {
+		if t, ok := s.vars[name]; ok {
+			return t, t.expr != nil
+		}
+	}
+	return typ{}, false
+}
+
+func (s *scope) declared(name string) bool {
+	for s := s; s != nil; s = s.parent {
+		if _, ok := s.vars[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+type visitor struct {
+	loader *loader
+	pkg    *packageInfo
+	file   *fileInfo
+
+	cases      []strictCase
+	unresolved []string
+}
+
+func (v *visitor) visitPackage() {
+	slices.SortFunc(v.pkg.files, func(a, b *fileInfo) int {
+		return cmp.Compare(a.name, b.name)
+	})
+	for _, file := range v.pkg.files {
+		if !slices.Contains(slices.Collect(maps.Values(file.imports)), flexImportPath) {
+			continue
+		}
+		v.file = file
+		for _, decl := range file.file.Decls {
+			if decl, ok := decl.(*ast.FuncDecl); ok && decl.Body != nil {
+				v.visitFunc(decl.Recv, decl.Type, decl.Body, nil)
+			}
+		}
+	}
+}
+
+func (v *visitor) visitFunc(recv *ast.FieldList, ft *ast.FuncType, body *ast.BlockStmt, parent *scope) {
+	s := newScope(parent)
+	for _, fields := range []*ast.FieldList{recv, ft.Params, ft.Results} {
+		if fields == nil {
+			continue
+		}
+		for _, field := range fields.List {
+			for _, name := range field.Names {
+				s.declare(name.Name, typ{expr: field.Type, file: v.file})
+			}
+		}
+	}
+	v.visitStmts(body.List, s)
+}
+
+func (v *visitor) visitStmts(stmts []ast.Stmt, s *scope) {
+	for _, stmt := range stmts {
+		v.visitStmt(stmt, s)
+	}
+}
+
+func (v *visitor) visitStmt(stmt ast.Stmt, s *scope) {
+	switch stmt := stmt.(type) {
+	case *ast.BlockStmt:
+		v.visitStmts(stmt.List, newScope(s))
+	case *ast.DeclStmt:
+		decl, ok := stmt.Decl.(*ast.GenDecl)
+		if !ok || decl.Tok != token.VAR {
+			return
+		}
+		for _, spec := range decl.Specs {
+			spec := spec.(*ast.ValueSpec)
+			for _, value := range spec.Values {
+				v.visitExpr(value, s)
+			}
+			for i, name := range spec.Names {
+				switch {
+				case spec.Type != nil:
+					s.declare(name.Name, typ{expr: spec.Type, file: v.file})
+				case len(spec.Values) == len(spec.Names):
+					t, _ := v.typeOf(spec.Values[i], s)
+					s.declare(name.Name, t)
+				default:
+					t, _ := v.resultOf(spec.Values[0], i, s)
+					s.declare(name.Name, t)
+				}
+			}
+		}
+	case *ast.AssignStmt:
+		for _, expr := range stmt.Rhs {
+			v.visitExpr(expr, s)
+		}
+		if stmt.Tok != token.DEFINE {
+			return
+		}
+		for i, expr := range stmt.Lhs {
+			ident, ok := expr.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			var t typ
+			if len(stmt.Rhs) == len(stmt.Lhs) {
+				t, _ = v.typeOf(stmt.Rhs[i], s)
+			} else {
+				t, _ = v.resultOf(stmt.Rhs[0], i, s)
+			}
+			s.declare(ident.Name, t)
+		}
+	case *ast.ExprStmt:
+		v.visitExpr(stmt.X, s)
+	case *ast.ReturnStmt:
+		for _, expr := range stmt.Results {
+			v.visitExpr(expr, s)
+		}
+	case *ast.DeferStmt:
+		v.visitExpr(stmt.Call, s)
+	case *ast.GoStmt:
+		v.visitExpr(stmt.Call, s)
+	case *ast.SendStmt:
+		v.visitExpr(stmt.Value, s)
+	case *ast.LabeledStmt:
+		v.visitStmt(stmt.Stmt, s)
+	case *ast.IfStmt:
+		s = newScope(s)
+		if stmt.Init != nil {
+			v.visitStmt(stmt.Init, s)
+		}
+		v.visitExpr(stmt.Cond, s)
+		v.visitStmt(stmt.Body, s)
+		if stmt.Else != nil {
+			v.visitStmt(stmt.Else, s)
+		}
+	case *ast.ForStmt:
+		s = newScope(s)
+		if stmt.Init != nil {
+			v.visitStmt(stmt.Init, s)
+		}
+		v.visitStmt(stmt.Body, s)
+	case *ast.RangeStmt:
+		v.visitExpr(stmt.X, s)
+		s = newScope(s)
+		if stmt.Tok == token.DEFINE {
+			var key, value typ
+			if t, ok := v.typeOf(stmt.X, s); ok {
+				if u, ok := v.loader.underlying(t); ok {
+					switch expr := u.expr.(type) {
+					case *ast.ArrayType:
+						value = typ{expr: expr.Elt, file: u.file}
+					case *ast.MapType:
+						key = typ{expr: expr.Key, file: u.file}
+						value = typ{expr: expr.Value, file: u.file}
+					}
+				}
+			}
+			if ident, ok := stmt.Key.(*ast.Ident); ok {
+				s.declare(ident.Name, key)
+			}
+			if ident, ok := stmt.Value.(*ast.Ident); ok {
+				s.declare(ident.Name, value)
+			}
+		}
+		v.visitStmt(stmt.Body, s)
+	case *ast.SwitchStmt:
+		s = newScope(s)
+		if stmt.Init != nil {
+			v.visitStmt(stmt.Init, s)
+		}
+		if stmt.Tag != nil {
+			v.visitExpr(stmt.Tag, s)
+		}
+		for _, clause := range stmt.Body.List {
+			clause := clause.(*ast.CaseClause)
+			for _, expr := range clause.List {
+				v.visitExpr(expr, s)
+			}
+			v.visitStmts(clause.Body, newScope(s))
+		}
+	case *ast.TypeSwitchStmt:
+		s = newScope(s)
+		if stmt.Init != nil {
+			v.visitStmt(stmt.Init, s)
+		}
+		var name string
+		var x ast.Expr
+		switch assign := stmt.Assign.(type) {
+		case *ast.AssignStmt:
+			name = assign.Lhs[0].(*ast.Ident).Name
+			x = assign.Rhs[0].(*ast.TypeAssertExpr).X
+		case *ast.ExprStmt:
+			x = assign.X.(*ast.TypeAssertExpr).X
+		}
+		v.visitExpr(x, s)
+		for _, clause := range stmt.Body.List {
+			clause := clause.(*ast.CaseClause)
+			cs := newScope(s)
+			if name != "" {
+				var t typ
+				if len(clause.List) == 1 {
+					if ident, ok := clause.List[0].(*ast.Ident); !ok || ident.Name != "nil" {
+						t = typ{expr: clause.List[0], file: v.file}
+					}
+				} else {
+					t, _ = v.typeOf(x, s)
+				}
+				cs.declare(name, t)
+			}
+			v.visitStmts(clause.Body, cs)
+		}
+	case *ast.SelectStmt:
+		for _, clause := range stmt.Body.List {
+			clause := clause.(*ast.CommClause)
+			cs := newScope(s)
+			if clause.Comm != nil {
+				v.visitStmt(clause.Comm, cs)
+			}
+			v.visitStmts(clause.Body, cs)
+		}
+	}
+}
+
+// visitExpr records Expand and Flatten calls in an expression and visits function literals.
+func (v *visitor) visitExpr(expr ast.Expr, s *scope) {
+	if expr == nil {
+		return
+	}
+	ast.Inspect(expr, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			v.visitFunc(nil, n.Type, n.Body, s)
+			return false
+		case *ast.CallExpr:
+			v.visitCall(n, s)
+		}
+		return true
+	})
+}
+
+func (v *visitor) visitCall(call *ast.CallExpr, s *scope) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+	x, ok := sel.X.(*ast.Ident)
+	if !ok || s.declared(x.Name) || v.file.imports[x.Name] != flexImportPath {
+		return
+	}
+	function := sel.Sel.Name
+	if function != "Expand" && function != "Flatten" {
+		return
+	}
+
+	pos := v.loader.fset.Position(call.Pos())
+	position := fmt.Sprintf("%s:%d", v.file.name, pos.Line)
+	if len(call.Args) < 3 || call.Ellipsis.IsValid() {
+		v.unresolved = append(v.unresolved, fmt.Sprintf("%s: unsupported %s.%s arguments", position, x.Name, function))
+		return
+	}
+
+	source, ok := v.typeOf(call.Args[1], s)
+	if value, _ := source.deref(); !ok || !v.loader.isNamedStruct(value) {
+		v.unresolved = append(v.unresolved, fmt.Sprintf("%s: %s.%s source type not resolved", position, x.Name, function))
+		return
+	}
+
+	target, ok := v.typeOf(call.Args[2], s)
+	if ok {
+		target, ok = target.deref()
+	}
+	if !ok || !v.loader.isNamedStruct(target) {
+		v.unresolved = append(v.unresolved, fmt.Sprintf("%s: %s.%s target type not resolved", position, x.Name, function))
+		return
+	}
+
+	var options []string
+	for _, arg := range call.Args[3:] {
+		option, ok := v.option(arg, x.Name)
+		if !ok {
+			v.unresolved = append(v.unresolved, fmt.Sprintf("%s: %s.%s options not resolved", position, x.Name, function))
+			return
+		}
+		options = append(options, option)
+	}
+
+	v.cases = append(v.cases, strictCase{
+		position: position,
+		function: function,
+		source:   source,
+		target:   target.pointer(),
+		options:  options,
+	})
+}
+
+// option returns the source of an AutoFlex option that only has literal arguments.
+func (v *visitor) option(expr ast.Expr, flexAlias string) (string, bool) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return "", false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return "", false
+	}
+	if x, ok := sel.X.(*ast.Ident); !ok || x.Name != flexAlias {
+		return "", false
+	}
+
+	args := make([]string, 0, len(call.Args))
+	for _, arg := range call.Args {
+		lit, ok := arg.(*ast.BasicLit)
+		if !ok {
+			return "", false
+		}
+		args = append(args, lit.Value)
+	}
+
+	return fmt.Sprintf("fwflex.%s(%s)", sel.Sel.Name, strings.Join(args, ", ")), true
+}
+
+// typeOf returns the type of an expression.
+func (v *visitor) typeOf(expr ast.Expr, s *scope) (typ, bool) {
+	switch expr := expr.(type) {
+	case *ast.ParenExpr:
+		return v.typeOf(expr.X, s)
+	case *ast.Ident:
+		return s.lookup(expr.Name)
+	case *ast.UnaryExpr:
+		if expr.Op != token.AND {
+			return typ{}, false
+		}
+		if t, ok := v.typeOf(expr.X, s); ok {
+			return t.pointer(), true
+		}
+	case *ast.StarExpr:
+		if t, ok := v.typeOf(expr.X, s); ok {
+			return t.deref()
+		}
+	case *ast.SelectorExpr:
+		if x, ok := expr.X.(*ast.Ident); ok && !s.declared(x.Name) {
+			// Package-level variable.
+			return typ{}, false
+		}
+		if t, ok := v.typeOf(expr.X, s); ok {
+			return v.loader.field(t, expr.Sel.Name)
+		}
+	case *ast.IndexExpr:
+		if t, ok := v.typeOf(expr.X, s); ok {
+			return v.loader.elem(t)
+		}
+	case *ast.CompositeLit:
+		if expr.Type != nil {
+			return typ{expr: expr.Type, file: v.file}, true
+		}
+	case *ast.TypeAssertExpr:
+		if expr.Type != nil {
+			return typ{expr: expr.Type, file: v.file}, true
+		}
+	case *ast.CallExpr:
+		return v.resultOf(expr, 0, s)
+	}
+
+	return typ{}, false
+}
+
+// resultOf returns the type of the i'th value of a multi-valued expression.
+func (v *visitor) resultOf(expr ast.Expr, i int, s *scope) (typ, bool) {
+	switch expr := expr.(type) {
+	case *ast.ParenExpr:
+		return v.resultOf(expr.X, i, s)
+	case *ast.TypeAssertExpr, *ast.IndexExpr:
+		if i == 0 {
+			return v.typeOf(expr, s)
+		}
+		return typ{}, false
+	case *ast.CallExpr:
+	default:
+		return typ{}, false
+	}
+
+	call := expr.(*ast.CallExpr)
+	var fd funcDecl
+	var ok bool
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		if s.declared(fun.Name) {
+			return typ{}, false
+		}
+		fd, ok = v.pkg.funcs[fun.Name]
+	case *ast.SelectorExpr:
+		if x, isIdent := fun.X.(*ast.Ident); isIdent && !s.declared(x.Name) {
+			if importPath, isImport := v.file.imports[x.Name]; isImport {
+				if pkg := v.loader.load(importPath); pkg != nil {
+					fd, ok = pkg.funcs[fun.Sel.Name]
+				}
+				break
+			}
+		}
+		if t, resolved := v.typeOf(fun.X, s); resolved {
+			t, _ = t.deref()
+			if decl, named := v.loader.named(t); named {
+				pkg := decl.file.pkg
+				fd, ok = pkg.funcs[decl.spec.Name.Name+"."+fun.Sel.Name]
+			}
+		}
+		if !ok && i == 0 {
+			return v.sdkOutput(fun.Sel.Name)
+		}
+	}
+	if !ok {
+		return typ{}, false
+	}
+
+	types := results(fd)
+	if i >= len(types) {
+		return typ{}, false
+	}
+
+	return types[i], true
+}
+
+// sdkOutput returns the output type of an AWS SDK for Go v2 client method imported by the current file.
+func (v *visitor) sdkOutput(method string) (typ, bool) {
+	var t typ
+	var n int
+	for alias, importPath := range v.file.imports {
+		if !strings.HasPrefix(importPath, sdkPrefix) || strings.HasSuffix(importPath, sdkTypesSuffix) {
+			continue
+		}
+		pkg := v.loader.load(importPath)
+		if pkg == nil {
+			continue
+		}
+		if _, ok := pkg.types[method+"Output"]; ok {
+			t = typ{
+				expr: &ast.StarExpr{X: &ast.SelectorExpr{X: ast.NewIdent(alias), Sel: ast.NewIdent(method + "Output")}},
+				file: v.file,
+			}
+			n++
+		}
+	}
+
+	return t, n == 1
+}
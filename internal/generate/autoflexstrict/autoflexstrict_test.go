@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package autoflexstrict
+
+import (
+	"bytes"
+	"go/format"
+	"os"
+	"path/filepath"
+	"testing"
+	"text/template"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testTypes = `package example
+
+import (
+	"context"
+
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+type resourceModel struct {
+	Name string
+}
+
+type apiObject struct {
+	Name *string
+}
+
+type getOutput struct {
+	Object *apiObject
+}
+
+func find(ctx context.Context) (*getOutput, error) {
+	return nil, nil
+}
+`
+
+func TestFind(t *testing.T) {
+	t.Parallel()
+
+	flexImport := Import{Alias: "fwflex", Path: flexImportPath}
+	flextestImport := Import{Path: flexImportPath + "/flextest"}
+
+	testCases := map[string]struct {
+		src         string
+		wantData    TemplateData
+		wantSkipped []string
+	}{
+		"no calls": {
+			src: `
+func create(ctx context.Context) {
+	_ = fwflex.StringValueFromFramework
+}
+`,
+			wantData: TemplateData{PackageName: "example", Imports: []Import{flextestImport}},
+		},
+		"expand": {
+			src: `
+func create(ctx context.Context, data resourceModel) {
+	var input apiObject
+	fwflex.Expand(ctx, data, &input)
+}
+`,
+			wantData: TemplateData{
+				PackageName: "example",
+				Imports:     []Import{flextestImport},
+				Expand: []TestCase{
+					{Name: "resourceModel to apiObject", Source: "resourceModel{}", Target: "&apiObject{}"},
+				},
+			},
+		},
+		"flatten function result field": {
+			src: `
+func read(ctx context.Context) {
+	output, _ := find(ctx)
+	var data resourceModel
+	fwflex.Flatten(ctx, output.Object, &data)
+}
+`,
+			wantData: TemplateData{
+				PackageName: "example",
+				Imports:     []Import{flextestImport},
+				Flatten: []TestCase{
+					{Name: "apiObject to resourceModel", Source: "&apiObject{}", Target: "&resourceModel{}"},
+				},
+			},
+		},
+		"range element": {
+			src: `
+func read(ctx context.Context, objects []apiObject) {
+	for _, v := range objects {
+		var data resourceModel
+		fwflex.Flatten(ctx, v, &data)
+	}
+}
+`,
+			wantData: TemplateData{
+				PackageName: "example",
+				Imports:     []Import{flextestImport},
+				Flatten: []TestCase{
+					{Name: "apiObject to resourceModel", Source: "apiObject{}", Target: "&resourceModel{}"},
+				},
+			},
+		},
+		"options and duplicates": {
+			src: `
+func create(ctx context.Context, data resourceModel) {
+	var input apiObject
+	fwflex.Expand(ctx, data, &input)
+	fwflex.Expand(ctx, data, &input)
+	fwflex.Expand(ctx, &data, &input, fwflex.WithFieldNamePrefix("Example"))
+}
+`,
+			wantData: TemplateData{
+				PackageName: "example",
+				Imports:     []Import{flexImport, flextestImport},
+				Expand: []TestCase{
+					{Name: "resourceModel to apiObject", Source: "resourceModel{}", Target: "&apiObject{}"},
+					{Name: "resourceModel to apiObject (2)", Source: "&resourceModel{}", Target: "&apiObject{}", Options: []string{`fwflex.WithFieldNamePrefix("Example")`}},
+				},
+			},
+		},
+		"unresolved": {
+			src: `
+func read(ctx context.Context, v any, prefix string) {
+	var data resourceModel
+	fwflex.Flatten(ctx, v, &data)
+	fwflex.Flatten(ctx, apiObject{}, &data, fwflex.WithFieldNamePrefix(prefix))
+}
+`,
+			wantData: TemplateData{PackageName: "example", Imports: []Import{flextestImport}},
+			wantSkipped: []string{
+				"example.go:27: fwflex.Flatten source type not resolved",
+				"example.go:28: fwflex.Flatten options not resolved",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, "example.go"), []byte(testTypes+testCase.src), 0644); err != nil { //nolint:mnd // test file
+				t.Fatal(err)
+			}
+
+			gotData, gotSkipped, err := Find(dir, "example")
+			if err != nil {
+				t.Fatalf("Find: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.wantData, gotData); diff != "" {
+				t.Errorf("unexpected template data diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(testCase.wantSkipped, gotSkipped); diff != "" {
+				t.Errorf("unexpected skipped diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTemplate(t *testing.T) {
+	t.Parallel()
+
+	tmpl, err := template.New("autoflexstrict").Parse(Template)
+	if err != nil {
+		t.Fatalf("parsing template: %s", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, TemplateData{
+		PackageName: "example",
+		Imports: []Import{
+			{Alias: "fwflex", Path: flexImportPath},
+			{Path: flexImportPath + "/flextest"},
+		},
+		Expand: []TestCase{
+			{Name: "resourceModel to apiObject", Source: "&resourceModel{}", Target: "&apiObject{}", Options: []string{`fwflex.WithFieldNamePrefix("Example")`}},
+		},
+		Flatten: []TestCase{
+			{Name: "apiObject to resourceModel", Source: "&apiObject{}", Target: "&resourceModel{}"},
+		},
+	}); err != nil {
+		t.Fatalf("executing template: %s", err)
+	}
+
+	if _, err := format.Source(buf.Bytes()); err != nil {
+		t.Errorf("generated source is not valid Go: %s\n%s", err, buf.String())
+	}
+}
+
+func TestImportName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"github.com/aws/aws-sdk-go-v2/service/athena/types":            "types",
+		"github.com/hashicorp/terraform-plugin-framework/types":        "types",
+		"github.com/hashicorp/terraform-plugin-sdk/v2":                 "terraform-plugin-sdk",
+		"github.com/hashicorp/terraform-provider-aws/internal/vpc/v10": "vpc",
+	}
+
+	for importPath, want := range testCases {
+		if got := importName(importPath); got != want {
+			t.Errorf("importName(%q) = %q, want %q", importPath, got, want)
+		}
+	}
+}
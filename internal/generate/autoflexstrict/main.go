@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build ignore
+// +build ignore
+
+// Finds every fwflex.Expand and fwflex.Flatten call in a service package whose
+// source and target types can be determined from the package's source code and
+// writes a test that runs each pair through AutoFlex in strict mode.
+package main
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/autoflexstrict"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+func main() {
+	const (
+		filename = `autoflex_strict_gen_test.go`
+	)
+	g := common.NewGenerator()
+
+	servicePackage := os.Getenv("GOPACKAGE")
+
+	g.Infof("Generating internal/service/%s/%s", servicePackage, filename)
+
+	templateData, skipped, err := autoflexstrict.Find(".", servicePackage)
+	if err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	for _, v := range skipped {
+		g.Infof("  Skipping %s", v)
+	}
+
+	if len(templateData.Expand) == 0 && len(templateData.Flatten) == 0 {
+		if err := os.Remove(filename); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			g.Fatalf("removing file (%s): %s", filename, err)
+		}
+		return
+	}
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.BufferTemplate("autoflexstrict", autoflexstrict.Template, templateData); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	g.Infof("  Generated %d test cases", len(templateData.Expand)+len(templateData.Flatten))
+}
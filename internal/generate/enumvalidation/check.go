@@ -0,0 +1,536 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package enumvalidation
+
+import (
+	"cmp"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	enumImportPath   = "github.com/hashicorp/terraform-provider-aws/internal/enum"
+	namesImportPath  = "github.com/hashicorp/terraform-provider-aws/names"
+	schemaImportPath = "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	providerPrefix   = "github.com/hashicorp/terraform-provider-aws/"
+	sdkPrefix        = "github.com/aws/aws-sdk-go-v2/service/"
+	sdkTypesSuffix   = "/types"
+)
+
+// unvalidatedEnumTypes are the AWS SDK for Go v2 enum types, by import path and type name, whose values
+// are intentionally not validated at plan time, with the reason.
+var unvalidatedEnumTypes = map[string]string{
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types.InstanceType":                             "New instance types are available before the AWS SDK is updated.",
+	"github.com/aws/aws-sdk-go-v2/service/elasticsearchservice/types.ESPartitionInstanceType": "New instance types are available before the AWS SDK is updated.",
+	"github.com/aws/aws-sdk-go-v2/service/opensearch/types.OpenSearchPartitionInstanceType":   "New instance types are available before the AWS SDK is updated.",
+	"github.com/aws/aws-sdk-go-v2/service/route53/types.VPCRegion":                            "New Regions are available before the AWS SDK is updated.",
+}
+
+// Finding is a schema attribute which is expanded into an enum type but not validated.
+type Finding struct {
+	File      string
+	Line      int
+	Attribute string
+	EnumType  string // e.g. "awstypes.Protocol".
+
+	literal *ast.CompositeLit // The schema literal to add validation to.
+}
+
+// ReadAttrConsts returns the values of the `names.Attr...` constants.
+func ReadAttrConsts(path string) (map[string]string, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	consts := make(map[string]string)
+	for _, decl := range f.Decls {
+		decl, ok := decl.(*ast.GenDecl)
+		if !ok || decl.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range decl.Specs {
+			spec := spec.(*ast.ValueSpec)
+			for i, name := range spec.Names {
+				if i >= len(spec.Values) {
+					continue
+				}
+				if s, ok := stringLiteral(spec.Values[i]); ok {
+					consts[name.Name] = s
+				}
+			}
+		}
+	}
+
+	return consts, nil
+}
+
+// schemaAttribute is a string (or list or set of string) attribute in a schema literal.
+type schemaAttribute struct {
+	name       string
+	collection bool
+	validated  bool
+	literal    *ast.CompositeLit // The literal to which validation applies.
+}
+
+// enumUsage is a conversion of an attribute's value into an enum type.
+type enumUsage struct {
+	attribute  string
+	collection bool
+	enumType   string
+}
+
+type fileChecker struct {
+	fset       *token.FileSet
+	file       *ast.File
+	attrConsts map[string]string
+
+	schemaAlias string
+	namesAlias  string
+	enumAlias   string
+	sdkTypes    map[string]string // Import paths of imported AWS SDK for Go v2 types packages, by alias.
+}
+
+// CheckFile returns the schema attributes in the specified file which are expanded into an enum type but not validated.
+// If fix is true, validation is added to each attribute's schema and the file is rewritten.
+func CheckFile(path string, attrConsts map[string]string, fix bool) ([]Finding, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, src, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &fileChecker{
+		fset:       fset,
+		file:       file,
+		attrConsts: attrConsts,
+		sdkTypes:   make(map[string]string),
+	}
+
+	for _, spec := range file.Imports {
+		importPath, _ := strconv.Unquote(spec.Path.Value)
+		alias := importPath[strings.LastIndex(importPath, "/")+1:]
+		if spec.Name != nil {
+			alias = spec.Name.Name
+		}
+
+		switch {
+		case importPath == schemaImportPath:
+			c.schemaAlias = alias
+		case importPath == namesImportPath:
+			c.namesAlias = alias
+		case importPath == enumImportPath:
+			c.enumAlias = alias
+		case strings.HasPrefix(importPath, sdkPrefix) && strings.HasSuffix(importPath, sdkTypesSuffix):
+			c.sdkTypes[alias] = importPath
+		}
+	}
+
+	if c.schemaAlias == "" || len(c.sdkTypes) == 0 {
+		return nil, nil
+	}
+
+	attributes := c.schemaAttributes()
+	usages := c.enumUsages()
+
+	// An attribute name used with more than one enum type, or declared more than once, is ambiguous.
+	enumTypes := make(map[string]map[string]bool)
+	for _, u := range usages {
+		if enumTypes[u.attribute] == nil {
+			enumTypes[u.attribute] = make(map[string]bool)
+		}
+		enumTypes[u.attribute][u.enumType] = true
+	}
+	declarations := make(map[string]int)
+	for _, a := range attributes {
+		declarations[a.name]++
+	}
+
+	var findings []Finding
+	seen := make(map[*ast.CompositeLit]bool)
+	for _, u := range usages {
+		if len(enumTypes[u.attribute]) != 1 || declarations[u.attribute] != 1 {
+			continue
+		}
+
+		for _, a := range attributes {
+			if a.name != u.attribute || a.collection != u.collection || a.validated || seen[a.literal] {
+				continue
+			}
+			seen[a.literal] = true
+
+			findings = append(findings, Finding{
+				File:      path,
+				Line:      fset.Position(a.literal.Pos()).Line,
+				Attribute: a.name,
+				EnumType:  u.enumType,
+				literal:   a.literal,
+			})
+		}
+	}
+
+	if fix && len(findings) > 0 {
+		if err := c.fix(path, src, findings); err != nil {
+			return nil, err
+		}
+	}
+
+	return findings, nil
+}
+
+// schemaAttributes returns all configurable string attributes in `map[string]*schema.Schema` literals.
+func (c *fileChecker) schemaAttributes() []schemaAttribute {
+	var attributes []schemaAttribute
+
+	ast.Inspect(c.file, func(n ast.Node) bool {
+		lit, ok := n.(*ast.CompositeLit)
+		if !ok {
+			return true
+		}
+		mapType, ok := lit.Type.(*ast.MapType)
+		if !ok || !c.isSchemaType(mapType.Value) {
+			return true
+		}
+
+		for _, elt := range lit.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			name, ok := c.attributeName(kv.Key)
+			if !ok {
+				continue
+			}
+			attrLit, ok := compositeLiteral(kv.Value)
+			if !ok {
+				continue
+			}
+
+			fields := keyedFields(attrLit)
+			if !isSelector(fields["Optional"], "", "true") && !isSelector(fields["Required"], "", "true") {
+				continue
+			}
+
+			switch typ := fields["Type"]; {
+			case isSelector(typ, c.schemaAlias, "TypeString"):
+				attributes = append(attributes, schemaAttribute{
+					name:      name,
+					validated: isValidated(fields),
+					literal:   attrLit,
+				})
+
+			case isSelector(typ, c.schemaAlias, "TypeList"), isSelector(typ, c.schemaAlias, "TypeSet"):
+				elemLit, ok := compositeLiteral(fields["Elem"])
+				if !ok || !c.isSchemaType(elemLit.Type) {
+					continue
+				}
+				elemFields := keyedFields(elemLit)
+				if !isSelector(elemFields["Type"], c.schemaAlias, "TypeString") {
+					continue
+				}
+				attributes = append(attributes, schemaAttribute{
+					name:       name,
+					collection: true,
+					validated:  isValidated(elemFields),
+					literal:    elemLit,
+				})
+			}
+		}
+
+		return true
+	})
+
+	return attributes
+}
+
+// enumUsages returns all conversions of attribute values into AWS SDK for Go v2 enum types.
+func (c *fileChecker) enumUsages() []enumUsage {
+	var usages []enumUsage
+
+	for _, decl := range c.file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Body == nil {
+			continue
+		}
+
+		assignments := c.attributeAssignments(funcDecl.Body)
+
+		ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) != 1 {
+				return true
+			}
+
+			switch fun := call.Fun.(type) {
+			case *ast.SelectorExpr:
+				// awstypes.Protocol(v.(string)).
+				x, ok := fun.X.(*ast.Ident)
+				if !ok || !c.isValidatedEnumType(x.Name, fun.Sel.Name) {
+					return true
+				}
+				assert, ok := call.Args[0].(*ast.TypeAssertExpr)
+				if !ok || !isIdent(assert.Type, "string") {
+					return true
+				}
+				if name, ok := c.valueAttributeName(assert.X, assignments); ok {
+					usages = append(usages, enumUsage{
+						attribute: name,
+						enumType:  x.Name + "." + fun.Sel.Name,
+					})
+				}
+
+			case *ast.IndexExpr:
+				// flex.ExpandStringyValueSet[awstypes.Protocol](v.(*schema.Set)).
+				sel, ok := fun.X.(*ast.SelectorExpr)
+				if !ok || !strings.HasPrefix(sel.Sel.Name, "ExpandStringyValue") {
+					return true
+				}
+				typ, ok := fun.Index.(*ast.SelectorExpr)
+				if !ok {
+					return true
+				}
+				x, ok := typ.X.(*ast.Ident)
+				if !ok || !c.isValidatedEnumType(x.Name, typ.Sel.Name) {
+					return true
+				}
+				arg := call.Args[0]
+				if assert, ok := arg.(*ast.TypeAssertExpr); ok {
+					arg = assert.X
+				}
+				if name, ok := c.valueAttributeName(arg, assignments); ok {
+					usages = append(usages, enumUsage{
+						attribute:  name,
+						collection: true,
+						enumType:   x.Name + "." + typ.Sel.Name,
+					})
+				}
+			}
+
+			return true
+		})
+	}
+
+	return usages
+}
+
+// isValidatedEnumType returns whether the specified type is an AWS SDK for Go v2 enum type whose values
+// should be validated at plan time.
+func (c *fileChecker) isValidatedEnumType(alias, name string) bool {
+	importPath, ok := c.sdkTypes[alias]
+	if !ok {
+		return false
+	}
+	_, ok = unvalidatedEnumTypes[importPath+"."+name]
+	return !ok
+}
+
+// attributeAssignment records the assignment of an attribute's value to a local variable, e.g.
+//
+//	if v, ok := d.GetOk("protocol"); ok {
+type attributeAssignment struct {
+	pos       token.Pos
+	attribute string
+}
+
+// attributeAssignments returns the attribute value assignments in a function body, by variable name.
+func (c *fileChecker) attributeAssignments(body *ast.BlockStmt) map[string][]attributeAssignment {
+	assignments := make(map[string][]attributeAssignment)
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok || len(assign.Rhs) != 1 || len(assign.Lhs) == 0 {
+			return true
+		}
+		ident, ok := assign.Lhs[0].(*ast.Ident)
+		if !ok {
+			return true
+		}
+		rhs := assign.Rhs[0]
+		if assert, ok := rhs.(*ast.TypeAssertExpr); ok {
+			rhs = assert.X
+		}
+		if name, ok := c.valueAttributeName(rhs, nil); ok {
+			assignments[ident.Name] = append(assignments[ident.Name], attributeAssignment{
+				pos:       assign.Pos(),
+				attribute: name,
+			})
+		}
+
+		return true
+	})
+
+	return assignments
+}
+
+// valueAttributeName returns the name of the attribute whose value is read by the specified expression.
+func (c *fileChecker) valueAttributeName(expr ast.Expr, assignments map[string][]attributeAssignment) (string, bool) {
+	switch expr := expr.(type) {
+	case *ast.CallExpr:
+		// d.Get("protocol"), d.GetOk("protocol").
+		sel, ok := expr.Fun.(*ast.SelectorExpr)
+		if !ok || len(expr.Args) != 1 {
+			return "", false
+		}
+		switch sel.Sel.Name {
+		case "Get", "GetOk", "GetRawConfigAt":
+			return c.attributeName(expr.Args[0])
+		}
+
+	case *ast.IndexExpr:
+		// tfMap["protocol"].
+		return c.attributeName(expr.Index)
+
+	case *ast.Ident:
+		// v, where v was assigned from d.GetOk("protocol").
+		var name string
+		for _, a := range assignments[expr.Name] {
+			if a.pos < expr.Pos() {
+				name = a.attribute
+			}
+		}
+		return name, name != ""
+	}
+
+	return "", false
+}
+
+// attributeName returns the attribute name for a string literal or `names.Attr...` constant.
+func (c *fileChecker) attributeName(expr ast.Expr) (string, bool) {
+	if s, ok := stringLiteral(expr); ok {
+		return s, true
+	}
+	if sel, ok := expr.(*ast.SelectorExpr); ok && c.namesAlias != "" && isIdent(sel.X, c.namesAlias) {
+		s, ok := c.attrConsts[sel.Sel.Name]
+		return s, ok
+	}
+	return "", false
+}
+
+// isSchemaType returns whether the expression is `schema.Schema` or `*schema.Schema`.
+func (c *fileChecker) isSchemaType(expr ast.Expr) bool {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	return isSelector(expr, c.schemaAlias, "Schema")
+}
+
+// fix adds enum validation to each Finding's schema literal and rewrites the file.
+func (c *fileChecker) fix(path string, src []byte, findings []Finding) error {
+	type insertion struct {
+		offset int
+		text   string
+	}
+	var insertions []insertion
+
+	enumAlias := c.enumAlias
+	if enumAlias == "" {
+		enumAlias = "enum"
+
+		// Add the import alongside the other provider packages.
+		offset := -1
+		for _, spec := range c.file.Imports {
+			if importPath, _ := strconv.Unquote(spec.Path.Value); strings.HasPrefix(importPath, providerPrefix) {
+				offset = c.fset.Position(spec.Pos()).Offset
+				break
+			}
+		}
+		if offset == -1 {
+			return fmt.Errorf("no import of %s* found", providerPrefix)
+		}
+		insertions = append(insertions, insertion{
+			offset: offset,
+			text:   strconv.Quote(enumImportPath) + "\n",
+		})
+	}
+
+	for _, v := range findings {
+		text := fmt.Sprintf("ValidateDiagFunc: %s.Validate[%s](),\n", enumAlias, v.EnumType)
+		if n := len(v.literal.Elts); n > 0 && c.fset.Position(v.literal.Elts[n-1].End()).Line == c.fset.Position(v.literal.Rbrace).Line {
+			// Single line literal.
+			text = ", " + strings.TrimSuffix(text, ",\n")
+		}
+		insertions = append(insertions, insertion{
+			offset: c.fset.Position(v.literal.Rbrace).Offset,
+			text:   text,
+		})
+	}
+
+	slices.SortFunc(insertions, func(a, b insertion) int {
+		return cmp.Compare(b.offset, a.offset)
+	})
+
+	for _, v := range insertions {
+		src = slices.Insert(src, v.offset, []byte(v.text)...)
+	}
+
+	src, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("formatting: %w", err)
+	}
+
+	return os.WriteFile(path, src, 0644) //nolint:mnd // good protection for new files
+}
+
+// keyedFields returns a composite literal's fields by key.
+func keyedFields(lit *ast.CompositeLit) map[string]ast.Expr {
+	fields := make(map[string]ast.Expr)
+	for _, elt := range lit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			if key, ok := kv.Key.(*ast.Ident); ok {
+				fields[key.Name] = kv.Value
+			}
+		}
+	}
+	return fields
+}
+
+func isValidated(fields map[string]ast.Expr) bool {
+	_, validateFunc := fields["ValidateFunc"]
+	_, validateDiagFunc := fields["ValidateDiagFunc"]
+	return validateFunc || validateDiagFunc
+}
+
+// compositeLiteral returns the composite literal for `{...}` or `&T{...}`.
+func compositeLiteral(expr ast.Expr) (*ast.CompositeLit, bool) {
+	if unary, ok := expr.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+		expr = unary.X
+	}
+	lit, ok := expr.(*ast.CompositeLit)
+	return lit, ok
+}
+
+func stringLiteral(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	return s, err == nil
+}
+
+func isIdent(expr ast.Expr, name string) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == name
+}
+
+// isSelector returns whether the expression is `x.sel`, or the identifier `sel` if x is empty.
+func isSelector(expr ast.Expr, x, sel string) bool {
+	if x == "" {
+		return isIdent(expr, sel)
+	}
+	s, ok := expr.(*ast.SelectorExpr)
+	return ok && isIdent(s.X, x) && s.Sel.Name == sel
+}
@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package enumvalidation
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testFileHeader = `package example
+
+import (
+	awstypes "github.com/aws/aws-sdk-go-v2/service/example/types"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+`
+
+func TestCheckFile(t *testing.T) {
+	t.Parallel()
+
+	attrConsts := map[string]string{
+		"AttrProtocol": "protocol",
+	}
+
+	testCases := map[string]struct {
+		src  string
+		want []string
+	}{
+		"no validation": {
+			src: `
+func resourceExample() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func expand(d *schema.ResourceData) {
+	_ = awstypes.Protocol(d.Get("protocol").(string))
+}
+`,
+			want: []string{"protocol: awstypes.Protocol"},
+		},
+		"validated": {
+			src: `
+func resourceExample() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"protocol": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.Protocol](),
+			},
+		},
+	}
+}
+
+func expand(d *schema.ResourceData) {
+	_ = awstypes.Protocol(d.Get("protocol").(string))
+}
+`,
+		},
+		"computed only": {
+			src: `
+func resourceExample() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expand(d *schema.ResourceData) {
+	_ = awstypes.Protocol(d.Get("protocol").(string))
+}
+`,
+		},
+		"names constant and assigned value": {
+			src: `
+func resourceExample() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrProtocol: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func expand(d *schema.ResourceData) {
+	if v, ok := d.GetOk(names.AttrProtocol); ok {
+		_ = awstypes.Protocol(v.(string))
+	}
+}
+`,
+			want: []string{"protocol: awstypes.Protocol"},
+		},
+		"set of strings": {
+			src: `
+func resourceExample() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"protocols": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func expand(tfMap map[string]any) {
+	_ = flex.ExpandStringyValueSet[awstypes.Protocol](tfMap["protocols"].(*schema.Set))
+}
+`,
+			want: []string{"protocols: awstypes.Protocol"},
+		},
+		"ambiguous enum type": {
+			src: `
+func resourceExample() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func expand(d *schema.ResourceData, tfMap map[string]any) {
+	_ = awstypes.Protocol(d.Get("protocol").(string))
+	_ = awstypes.ListenerProtocol(tfMap["protocol"].(string))
+}
+`,
+		},
+		"unvalidated enum type": {
+			src: `
+func resourceExample() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"instance_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func expand(d *schema.ResourceData) {
+	_ = ec2types.InstanceType(d.Get("instance_type").(string))
+}
+`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			path := writeTestFile(t, testCase.src)
+
+			findings, err := CheckFile(path, attrConsts, false)
+			if err != nil {
+				t.Fatalf("CheckFile: %s", err)
+			}
+
+			var got []string
+			for _, v := range findings {
+				got = append(got, v.Attribute+": "+v.EnumType)
+				if v.File != path {
+					t.Errorf("file = %q, want %q", v.File, path)
+				}
+			}
+
+			if diff := cmp.Diff(testCase.want, got); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckFileFix(t *testing.T) {
+	t.Parallel()
+
+	path := writeTestFile(t, `
+func resourceExample() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"protocols": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func expand(d *schema.ResourceData) {
+	_ = awstypes.Protocol(d.Get("protocol").(string))
+	_ = flex.ExpandStringyValueList[awstypes.Protocol](d.Get("protocols").([]any))
+}
+`)
+
+	findings, err := CheckFile(path, nil, true)
+	if err != nil {
+		t.Fatalf("CheckFile: %s", err)
+	}
+	if got, want := len(findings), 2; got != want {
+		t.Fatalf("findings = %d, want %d", got, want)
+	}
+
+	src, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		`"github.com/hashicorp/terraform-provider-aws/internal/enum"`,
+		"ValidateDiagFunc: enum.Validate[awstypes.Protocol](),\n",
+		"Elem:     &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: enum.Validate[awstypes.Protocol]()},",
+	} {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("fixed source does not contain %q:\n%s", want, src)
+		}
+	}
+
+	// Fixed findings are not reported again.
+	findings, err = CheckFile(path, nil, false)
+	if err != nil {
+		t.Fatalf("CheckFile: %s", err)
+	}
+	if len(findings) != 0 {
+		t.Errorf("findings after fix = %d, want 0", len(findings))
+	}
+}
+
+func writeTestFile(t *testing.T, src string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "example.go")
+	if err := os.WriteFile(path, []byte(testFileHeader+src), 0644); err != nil { //nolint:mnd // test file
+		t.Fatal(err)
+	}
+
+	return path
+}
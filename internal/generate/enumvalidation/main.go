@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+// Scans Plugin SDK V2 schemas for string attributes that are expanded into an AWS SDK for Go v2
+// enum type but have no plan-time validation, e.g.
+//
+//	"protocol": {
+//		Type:     schema.TypeString,
+//		Optional: true,
+//	},
+//	...
+//	input.Protocol = awstypes.Protocol(d.Get("protocol").(string))
+//
+// and writes a report of each such attribute. Run with -fix to add
+// `ValidateDiagFunc: enum.Validate[awstypes.Protocol]()` to the attribute's schema.
+package main
+
+import (
+	"bytes"
+	"cmp"
+	"flag"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/enumvalidation"
+)
+
+const (
+	filename       = `report.txt`
+	servicesDir    = `../../service`
+	attrConstsFile = `../../../names/attr_consts_gen.go`
+)
+
+var (
+	fix = flag.Bool("fix", false, "add missing ValidateDiagFunc to schemas")
+)
+
+func main() {
+	flag.Parse()
+
+	g := common.NewGenerator()
+
+	g.Infof("Generating %s", filepath.Join("internal/generate/enumvalidation", filename))
+
+	attrConsts, err := enumvalidation.ReadAttrConsts(attrConstsFile)
+	if err != nil {
+		g.Fatalf("reading %s: %s", attrConstsFile, err)
+	}
+
+	var findings []enumvalidation.Finding
+
+	err = filepath.WalkDir(servicesDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") || strings.HasSuffix(path, "_gen.go") {
+			return nil
+		}
+
+		v, err := enumvalidation.CheckFile(path, attrConsts, *fix)
+		if err != nil {
+			return fmt.Errorf("checking %s: %w", path, err)
+		}
+
+		relPath, err := filepath.Rel(servicesDir, path)
+		if err != nil {
+			return err
+		}
+		for i := range v {
+			v[i].File = filepath.ToSlash(relPath)
+		}
+		findings = append(findings, v...)
+
+		return nil
+	})
+	if err != nil {
+		g.Fatalf("walking %s: %s", servicesDir, err)
+	}
+
+	slices.SortFunc(findings, func(a, b enumvalidation.Finding) int {
+		return cmp.Or(
+			cmp.Compare(a.File, b.File),
+			cmp.Compare(a.Line, b.Line),
+		)
+	})
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "# Plugin SDK V2 string attributes expanded into an AWS SDK for Go v2 enum type without plan-time validation.")
+	fmt.Fprintln(&buf, "# Code generated by internal/generate/enumvalidation/main.go; DO NOT EDIT.")
+	fmt.Fprintln(&buf, "# Run `go run -tags generate main.go -fix` in internal/generate/enumvalidation to add ValidateDiagFunc.")
+	for _, v := range findings {
+		fmt.Fprintf(&buf, "%s:%d: %q: %s\n", v.File, v.Line, v.Attribute, v.EnumType)
+	}
+
+	d := g.NewUnformattedFileDestination(filename)
+
+	if err := d.BufferBytes(buf.Bytes()); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	g.Infof("  Found %d attributes without enum validation", len(findings))
+}
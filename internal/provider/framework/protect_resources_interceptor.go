@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type resourceProtectResourcesInterceptor struct {
+	resourceNoOpCRUDInterceptor
+	typeName string
+}
+
+func (r resourceProtectResourcesInterceptor) delete(ctx context.Context, opts interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]) {
+	c := opts.c
+
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case Before:
+		config := c.ProtectResourcesConfig(ctx)
+		if config == nil {
+			return
+		}
+
+		// Resources without tags_all can only be matched by resource type.
+		var stateTags tftags.Map
+		if _, diags := request.State.Schema.AttributeAtPath(ctx, path.Root(names.AttrTagsAll)); !diags.HasError() {
+			response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTagsAll), &stateTags)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		if rule, ok := config.Protects(r.typeName, tftags.New(ctx, stateTags)); ok {
+			tflog.Info(ctx, "Preventing deletion of protected resource", map[string]any{
+				"tf_aws.resource_type": r.typeName,
+			})
+			response.Diagnostics.AddError(interceptors.ProtectedResourceSummary, interceptors.ProtectedResourceDetail(r.typeName, rule))
+		}
+	}
+}
+
+// resourceProtectResources prevents the deletion of resources matching the provider's `protect_resources` configuration.
+func resourceProtectResources(typeName string) resourceCRUDInterceptor {
+	return &resourceProtectResourcesInterceptor{
+		typeName: typeName,
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+type mockProtectResourcesClient struct {
+	mockClient
+	config *conns.ProtectResourcesConfig
+}
+
+func (c mockProtectResourcesClient) ProtectResourcesConfig(context.Context) *conns.ProtectResourcesConfig {
+	return c.config
+}
+
+func Test_resourceProtectResourcesInterceptor(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+
+	taggedSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name":     schema.StringAttribute{Required: true},
+			"tags":     tftags.TagsAttribute(),
+			"tags_all": tftags.TagsAttributeComputedOnly(),
+		},
+	}
+	taggedState := tfsdk.State{
+		Raw: tftypes.NewValue(taggedSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "test"),
+			"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"tags_all": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"protected": tftypes.NewValue(tftypes.String, "true"),
+			}),
+		}),
+		Schema: taggedSchema,
+	}
+
+	untaggedSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{Required: true},
+		},
+	}
+	untaggedState := tfsdk.State{
+		Raw: tftypes.NewValue(untaggedSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "test"),
+		}),
+		Schema: untaggedSchema,
+	}
+
+	tests := map[string]struct {
+		config    *conns.ProtectResourcesConfig
+		state     tfsdk.State
+		expectErr bool
+	}{
+		"no config": {
+			state: taggedState,
+		},
+		"resource type": {
+			config: &conns.ProtectResourcesConfig{
+				Rules: []conns.ProtectResourcesRule{
+					{ResourceTypes: []string{"aws_test*"}},
+				},
+			},
+			state:     untaggedState,
+			expectErr: true,
+		},
+		"resource type no match": {
+			config: &conns.ProtectResourcesConfig{
+				Rules: []conns.ProtectResourcesRule{
+					{ResourceTypes: []string{"aws_s3_bucket"}},
+				},
+			},
+			state: untaggedState,
+		},
+		"tags": {
+			config: &conns.ProtectResourcesConfig{
+				Rules: []conns.ProtectResourcesRule{
+					{Tags: tftags.New(ctx, map[string]string{"protected": "true"})},
+				},
+			},
+			state:     taggedState,
+			expectErr: true,
+		},
+		"tags untagged resource": {
+			config: &conns.ProtectResourcesConfig{
+				Rules: []conns.ProtectResourcesRule{
+					{Tags: tftags.New(ctx, map[string]string{"protected": "true"})},
+				},
+			},
+			state: untaggedState,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]{
+				c: mockProtectResourcesClient{
+					config: tt.config,
+				},
+				request: &resource.DeleteRequest{
+					State: tt.state,
+				},
+				response: &resource.DeleteResponse{},
+				when:     Before,
+			}
+
+			resourceProtectResources("aws_test").delete(ctx, opts)
+
+			if got, want := opts.response.Diagnostics.HasError(), tt.expectErr; got != want {
+				t.Errorf("expected error %t, got diagnostics %v", want, opts.response.Diagnostics)
+			}
+		})
+	}
+}
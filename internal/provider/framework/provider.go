@@ -347,6 +347,26 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					},
 				},
 			},
+			"protect_resources": schema.ListNestedBlock{
+				Description: "Configuration block with settings to prevent the deletion of resources. " +
+					"Deletion of a resource matching any `protect_resources` block fails.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource type name patterns, e.g. `aws_s3_bucket` or `aws_kms_*`. " +
+								"If not set, resources of all types match.",
+						},
+						"tags": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tags that a resource must have to match. " +
+								"If not set, resources with any tags match.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -527,6 +527,9 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 
 	var interceptors interceptorInvocations
 
+	// Protection is checked before any other Delete interceptors are run.
+	interceptors = append(interceptors, resourceProtectResources(spec.TypeName))
+
 	if isRegionOverrideEnabled {
 		v := spec.Region.Value()
 
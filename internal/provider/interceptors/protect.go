@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	ProtectedResourceSummary = "Resource Protected From Deletion"
+)
+
+// ProtectedResourceDetail returns the detail of the diagnostic reported when the deletion of a resource
+// is prevented by the provider's `protect_resources` configuration.
+func ProtectedResourceDetail(typeName string, rule conns.ProtectResourcesRule) string {
+	var selectors []string
+	if len(rule.ResourceTypes) > 0 {
+		selectors = append(selectors, fmt.Sprintf("resource_types = %q", rule.ResourceTypes))
+	}
+	if len(rule.Tags) > 0 {
+		tags := make([]string, 0, len(rule.Tags))
+		for k, v := range rule.Tags.Map() {
+			tags = append(tags, fmt.Sprintf("%s = %q", k, v))
+		}
+		slices.Sort(tags)
+		selectors = append(selectors, fmt.Sprintf("tags = {%s}", strings.Join(tags, ", ")))
+	}
+
+	return fmt.Sprintf("This %s resource matches a provider protect_resources rule (%s) and cannot be destroyed.\n\n"+
+		"To destroy this resource, change or remove the matching protect_resources rule in the provider configuration.",
+		typeName, strings.Join(selectors, ", "))
+}
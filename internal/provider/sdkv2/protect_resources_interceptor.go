@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// protectResources prevents the deletion of resources matching the provider's `protect_resources` configuration.
+func protectResources(typeName string) crudInterceptor {
+	return interceptorFunc1[schemaResourceData, diag.Diagnostics](func(ctx context.Context, opts crudInterceptorOptions) diag.Diagnostics {
+		c := opts.c
+		var diags diag.Diagnostics
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case Delete:
+				config := c.ProtectResourcesConfig(ctx)
+				if config == nil {
+					return diags
+				}
+
+				// Resources without tags_all can only be matched by resource type.
+				stateTags := make(map[string]string)
+				if state := d.GetRawState(); !state.IsNull() && state.IsKnown() && state.Type().IsObjectType() && state.Type().HasAttribute(names.AttrTagsAll) {
+					if s := state.GetAttr(names.AttrTagsAll); !s.IsNull() && s.IsKnown() {
+						for k, v := range s.AsValueMap() {
+							if !v.IsNull() && v.IsKnown() {
+								stateTags[k] = v.AsString()
+							}
+						}
+					}
+				}
+
+				if rule, ok := config.Protects(typeName, tftags.New(ctx, stateTags)); ok {
+					tflog.Info(ctx, "Preventing deletion of protected resource", map[string]any{
+						"tf_aws.resource_type": typeName,
+						names.AttrID:           d.Id(),
+					})
+					return append(diags, errs.NewErrorDiagnostic(interceptors.ProtectedResourceSummary, interceptors.ProtectedResourceDetail(typeName, rule)))
+				}
+			}
+		}
+
+		return diags
+	})
+}
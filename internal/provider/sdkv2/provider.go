@@ -173,6 +173,30 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The profile for API operations. If not set, the default profile\n" +
 						"created with `aws configure` will be used.",
 				},
+				"protect_resources": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Description: "Configuration block with settings to prevent the deletion of resources. " +
+						"Deletion of a resource matching any `protect_resources` block fails.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"resource_types": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+								Description: "Resource type name patterns, e.g. `aws_s3_bucket` or `aws_kms_*`. " +
+									"If not set, resources of all types match.",
+							},
+							"tags": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+								Description: "Resource tags that a resource must have to match. " +
+									"If not set, resources with any tags match.",
+							},
+						},
+					},
+				},
 				"region": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -474,6 +498,13 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, nil)
 	}
 
+	protectCfg, dg := expandProtectResources(ctx, cty.GetAttrPath("protect_resources"), d.Get("protect_resources").([]any))
+	diags = append(diags, dg...)
+	if dg.HasError() {
+		return nil, diags
+	}
+	config.ProtectResourcesConfig = protectCfg
+
 	tagCfg, dg := expandTagPolicyConfig(cty.GetAttrPath("tag_policy_compliance"), d.Get("tag_policy_compliance").(string))
 	diags = append(diags, dg...)
 	if dg.HasError() {
@@ -652,6 +683,13 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 
 			var interceptors interceptorInvocations
 
+			// Protection is checked before any other Delete interceptors are run.
+			interceptors = append(interceptors, interceptorInvocation{
+				when:        Before,
+				why:         Delete,
+				interceptor: protectResources(typeName),
+			})
+
 			if isRegionOverrideEnabled {
 				v := resource.Region.Value()
 				s := r.SchemaMap()
@@ -1176,6 +1214,58 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 	return ignoreConfig
 }
 
+func expandProtectResources(ctx context.Context, path cty.Path, tfList []any) (*conns.ProtectResourcesConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(tfList) == 0 {
+		return nil, diags
+	}
+
+	config := &conns.ProtectResourcesConfig{}
+
+	for i, tfMapRaw := range tfList {
+		path := path.IndexInt(i)
+
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(path,
+				"Invalid protect_resources Block",
+				`At least one of "resource_types" or "tags" must be configured.`,
+			))
+			continue
+		}
+
+		var rule conns.ProtectResourcesRule
+
+		if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			rule.ResourceTypes = flex.ExpandStringValueSet(v)
+			slices.Sort(rule.ResourceTypes)
+
+			for _, pattern := range rule.ResourceTypes {
+				if err := conns.ValidateResourceTypePattern(pattern); err != nil {
+					diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("resource_types"), "Invalid resource type pattern %q: %s", pattern, err))
+				}
+			}
+		}
+
+		if v, ok := tfMap["tags"].(map[string]any); ok && len(v) > 0 {
+			rule.Tags = tftags.New(ctx, v)
+		}
+
+		if len(rule.ResourceTypes) == 0 && len(rule.Tags) == 0 {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(path,
+				"Invalid protect_resources Block",
+				`At least one of "resource_types" or "tags" must be configured.`,
+			))
+			continue
+		}
+
+		config.Rules = append(config.Rules, rule)
+	}
+
+	return config, diags
+}
+
 func expandTagPolicyConfig(path cty.Path, severity string) (*tftags.TagPolicyConfig, diag.Diagnostics) {
 	envSeverity := os.Getenv(tftags.TagPolicyComplianceEnvVar)
 	switch {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -425,6 +426,89 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandProtectResources(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	path := cty.GetAttrPath("protect_resources")
+	testcases := map[string]struct {
+		tfList         []any
+		expectedConfig *conns.ProtectResourcesConfig
+		expectError    bool
+	}{
+		"nil": {},
+		"resource types": {
+			tfList: []any{
+				map[string]any{
+					"resource_types": schema.NewSet(schema.HashString, []any{"aws_s3_bucket", "aws_kms_*"}),
+					"tags":           map[string]any{},
+				},
+			},
+			expectedConfig: &conns.ProtectResourcesConfig{
+				Rules: []conns.ProtectResourcesRule{
+					{ResourceTypes: []string{"aws_kms_*", "aws_s3_bucket"}},
+				},
+			},
+		},
+		"tags": {
+			tfList: []any{
+				map[string]any{
+					"resource_types": schema.NewSet(schema.HashString, nil),
+					"tags":           map[string]any{"protected": "true"},
+				},
+			},
+			expectedConfig: &conns.ProtectResourcesConfig{
+				Rules: []conns.ProtectResourcesRule{
+					{Tags: tftags.New(ctx, map[string]any{"protected": "true"})},
+				},
+			},
+		},
+		"empty block": {
+			tfList: []any{
+				nil,
+			},
+			expectError: true,
+		},
+		"empty arguments": {
+			tfList: []any{
+				map[string]any{
+					"resource_types": schema.NewSet(schema.HashString, nil),
+					"tags":           map[string]any{},
+				},
+			},
+			expectError: true,
+		},
+		"invalid pattern": {
+			tfList: []any{
+				map[string]any{
+					"resource_types": schema.NewSet(schema.HashString, []any{"aws_s3_[bucket"}),
+					"tags":           map[string]any{},
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := expandProtectResources(ctx, path, testcase.tfList)
+
+			if got, want := diags.HasError(), testcase.expectError; got != want {
+				t.Fatalf("Expected error %t, got diagnostics %v", want, diags)
+			}
+			if testcase.expectError {
+				return
+			}
+
+			if diff := cmp.Diff(testcase.expectedConfig, results); diff != "" {
+				t.Errorf("Unexpected protect_resources diff: %s", diff)
+			}
+		})
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
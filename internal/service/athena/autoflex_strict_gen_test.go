@@ -0,0 +1,37 @@
+// Code generated by internal/generate/autoflexstrict/main.go; DO NOT EDIT.
+
+package athena
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex/flextest"
+)
+
+func TestAutoFlexStrictExpand(t *testing.T) {
+	t.Parallel()
+
+	flextest.RunStrictExpandTestCases(t, flextest.StrictTestCases{
+		"capacityReservationResourceModel to athena.CreateCapacityReservationInput": {
+			Source: capacityReservationResourceModel{},
+			Target: &athena.CreateCapacityReservationInput{},
+		},
+		"capacityReservationResourceModel to athena.UpdateCapacityReservationInput": {
+			Source: capacityReservationResourceModel{},
+			Target: &athena.UpdateCapacityReservationInput{},
+		},
+	})
+}
+
+func TestAutoFlexStrictFlatten(t *testing.T) {
+	t.Parallel()
+
+	flextest.RunStrictFlattenTestCases(t, flextest.StrictTestCases{
+		"awstypes.CapacityReservation to capacityReservationResourceModel": {
+			Source: &awstypes.CapacityReservation{},
+			Target: &capacityReservationResourceModel{},
+		},
+	})
+}
@@ -0,0 +1,323 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package athena
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// queryPollInterval defines polling cadence for the query action.
+const queryPollInterval = 5 * time.Second
+
+// @Action(aws_athena_query, name="Query")
+func newQueryAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &queryAction{}, nil
+}
+
+var (
+	_ action.Action = (*queryAction)(nil)
+)
+
+type queryAction struct {
+	framework.ActionWithModel[queryActionModel]
+}
+
+type queryActionModel struct {
+	framework.WithRegionModel
+	Catalog          types.String `tfsdk:"catalog"`
+	Database         types.String `tfsdk:"database"`
+	ExpectedRowCount types.Int64  `tfsdk:"expected_row_count"`
+	OutputLocation   types.String `tfsdk:"output_location"`
+	QueryString      types.String `tfsdk:"query_string"`
+	Timeout          types.Int64  `tfsdk:"timeout"`
+	WorkGroup        types.String `tfsdk:"workgroup"`
+}
+
+func (a *queryAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an Athena query and waits for it to succeed. The action fails if the query fails or, optionally, if the number of result rows is not as expected.",
+		Attributes: map[string]schema.Attribute{
+			"catalog": schema.StringAttribute{
+				Description: "The name of the data catalog used in the query. Defaults to AwsDataCatalog",
+				Optional:    true,
+			},
+			names.AttrDatabase: schema.StringAttribute{
+				Description: "The name of the database used in the query",
+				Optional:    true,
+			},
+			"expected_row_count": schema.Int64Attribute{
+				Description: "The number of rows the query result set must contain, excluding the header row. The action fails if the row count differs",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"output_location": schema.StringAttribute{
+				Description: "The S3 location, e.g. s3://bucket/path/, where query results are stored. Required unless the workgroup specifies an output location",
+				Optional:    true,
+			},
+			"query_string": schema.StringAttribute{
+				Description: "The SQL query to run",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 262144),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the query to finish. Defaults to 1800 seconds (30 minutes)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+			"workgroup": schema.StringAttribute{
+				Description: "The name of the workgroup in which the query runs. Defaults to primary",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *queryAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config queryActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().AthenaClient(ctx)
+
+	timeout := 30 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting Athena query action", map[string]any{
+		"workgroup":        config.WorkGroup.ValueString(),
+		names.AttrDatabase: config.Database.ValueString(),
+		names.AttrTimeout:  timeout.String(),
+	})
+
+	input := athena.StartQueryExecutionInput{
+		QueryString: fwflex.StringFromFramework(ctx, config.QueryString),
+		WorkGroup:   fwflex.StringFromFramework(ctx, config.WorkGroup),
+	}
+
+	if !config.Catalog.IsNull() || !config.Database.IsNull() {
+		input.QueryExecutionContext = &awstypes.QueryExecutionContext{
+			Catalog:  fwflex.StringFromFramework(ctx, config.Catalog),
+			Database: fwflex.StringFromFramework(ctx, config.Database),
+		}
+	}
+
+	if !config.OutputLocation.IsNull() {
+		input.ResultConfiguration = &awstypes.ResultConfiguration{
+			OutputLocation: fwflex.StringFromFramework(ctx, config.OutputLocation),
+		}
+	}
+
+	output, err := conn.StartQueryExecution(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError("Starting Athena query", err.Error())
+		return
+	}
+
+	queryExecutionID := aws.ToString(output.QueryExecutionId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Query %s started, waiting for completion...", queryExecutionID),
+	})
+
+	var lastDataScanned int64
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.QueryExecution], error) {
+		queryExecution, err := findQueryExecutionByID(ctx, conn, queryExecutionID)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.QueryExecution]{}, err
+		}
+
+		if v := queryExecution.Statistics; v != nil {
+			if dataScanned := aws.ToInt64(v.DataScannedInBytes); dataScanned != lastDataScanned {
+				lastDataScanned = dataScanned
+				resp.SendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("Query %s is %s, %d bytes scanned", queryExecutionID, queryExecution.Status.State, dataScanned),
+				})
+			}
+		}
+
+		return actionwait.FetchResult[*awstypes.QueryExecution]{Status: actionwait.Status(queryExecution.Status.State), Value: queryExecution}, nil
+	}, actionwait.Options[*awstypes.QueryExecution]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(queryPollInterval),
+		ProgressInterval: 2 * time.Minute,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.QueryExecutionStateSucceeded),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.QueryExecutionStateQueued),
+			actionwait.Status(awstypes.QueryExecutionStateRunning),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.QueryExecutionStateFailed),
+			actionwait.Status(awstypes.QueryExecutionStateCancelled),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Query %s is %s (elapsed %s)", queryExecutionID, fr.Status, meta.Elapsed.Round(time.Second)),
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError("Query timeout", fmt.Sprintf("Query %s did not finish within %s (last status: %s)", queryExecutionID, timeout, timeoutErr.LastStatus))
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError("Query failed", queryExecutionFailureDetail(result.Value))
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected query status", err.Error())
+		} else {
+			resp.Diagnostics.AddError("Error waiting for query", err.Error())
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Query %s succeeded, %d bytes scanned", queryExecutionID, queryExecutionDataScanned(result.Value)),
+	})
+
+	if !config.ExpectedRowCount.IsNull() {
+		rowCount, err := queryResultRowCount(ctx, conn, result.Value)
+		if err != nil {
+			resp.Diagnostics.AddError("Reading Athena query results", err.Error())
+			return
+		}
+
+		if want := config.ExpectedRowCount.ValueInt64(); rowCount != want {
+			resp.Diagnostics.AddError("Unexpected Row Count", fmt.Sprintf("Query %s returned %d rows, expected %d", queryExecutionID, rowCount, want))
+			return
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Query %s returned %d rows as expected", queryExecutionID, rowCount),
+		})
+	}
+
+	tflog.Info(ctx, "Athena query action completed successfully", map[string]any{
+		"query_execution_id": queryExecutionID,
+	})
+}
+
+func findQueryExecutionByID(ctx context.Context, conn *athena.Client, id string) (*awstypes.QueryExecution, error) {
+	input := athena.GetQueryExecutionInput{
+		QueryExecutionId: aws.String(id),
+	}
+
+	output, err := conn.GetQueryExecution(ctx, &input)
+
+	if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "was not found") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.QueryExecution == nil || output.QueryExecution.Status == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return output.QueryExecution, nil
+}
+
+// queryResultRowCount returns the number of rows in a query's result set.
+// For DML (e.g. SELECT) queries Athena returns the column names as the first row, which is not counted.
+func queryResultRowCount(ctx context.Context, conn *athena.Client, queryExecution *awstypes.QueryExecution) (int64, error) {
+	input := athena.GetQueryResultsInput{
+		QueryExecutionId: queryExecution.QueryExecutionId,
+	}
+
+	var count int64
+	skipHeader := queryExecution.StatementType == awstypes.StatementTypeDml
+	pages := athena.NewGetQueryResultsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		if page.ResultSet == nil {
+			continue
+		}
+
+		rows := page.ResultSet.Rows
+		if skipHeader && len(rows) > 0 {
+			rows = rows[1:]
+			skipHeader = false
+		}
+
+		count += int64(len(rows))
+	}
+
+	return count, nil
+}
+
+func queryExecutionDataScanned(queryExecution *awstypes.QueryExecution) int64 {
+	if queryExecution == nil || queryExecution.Statistics == nil {
+		return 0
+	}
+
+	return aws.ToInt64(queryExecution.Statistics.DataScannedInBytes)
+}
+
+// queryExecutionFailureDetail describes why a query failed.
+func queryExecutionFailureDetail(queryExecution *awstypes.QueryExecution) string {
+	if queryExecution == nil || queryExecution.Status == nil {
+		return "Query failed"
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Query %s is %s", aws.ToString(queryExecution.QueryExecutionId), queryExecution.Status.State)
+	if reason := aws.ToString(queryExecution.Status.StateChangeReason); reason != "" {
+		fmt.Fprintf(&sb, ": %s", reason)
+	}
+	if v := queryExecution.Status.AthenaError; v != nil {
+		if message := aws.ToString(v.ErrorMessage); message != "" && message != aws.ToString(queryExecution.Status.StateChangeReason) {
+			fmt.Fprintf(&sb, "\n%s", message)
+		}
+		fmt.Fprintf(&sb, "\nError category: %d, error type: %d", aws.ToInt32(v.ErrorCategory), aws.ToInt32(v.ErrorType))
+		if v.Retryable {
+			sb.WriteString(" (retryable)")
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,357 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package autoscaling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// instanceRefreshPollInterval defines polling cadence for the instance refresh action.
+const instanceRefreshPollInterval = 15 * time.Second
+
+// @Action(aws_autoscaling_instance_refresh, name="Instance Refresh")
+func newInstanceRefreshAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &instanceRefreshAction{}, nil
+}
+
+var (
+	_ action.Action = (*instanceRefreshAction)(nil)
+)
+
+type instanceRefreshAction struct {
+	framework.ActionWithModel[instanceRefreshActionModel]
+}
+
+type instanceRefreshActionModel struct {
+	framework.WithRegionModel
+	AutoScalingGroupName types.String                                             `tfsdk:"autoscaling_group_name"`
+	Preferences          fwtypes.ListNestedObjectValueOf[refreshPreferencesModel] `tfsdk:"preferences"`
+	Timeout              types.Int64                                              `tfsdk:"timeout"`
+}
+
+type refreshPreferencesModel struct {
+	AutoRollback          types.Bool          `tfsdk:"auto_rollback"`
+	CheckpointDelay       types.Int64         `tfsdk:"checkpoint_delay"`
+	CheckpointPercentages fwtypes.ListOfInt64 `tfsdk:"checkpoint_percentages"`
+	InstanceWarmup        types.Int64         `tfsdk:"instance_warmup"`
+	MaxHealthyPercentage  types.Int64         `tfsdk:"max_healthy_percentage"`
+	MinHealthyPercentage  types.Int64         `tfsdk:"min_healthy_percentage"`
+	SkipMatching          types.Bool          `tfsdk:"skip_matching"`
+}
+
+func (a *instanceRefreshAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an instance refresh of an Auto Scaling group and waits for it to complete. The action fails if the instance refresh fails, is cancelled or is rolled back.",
+		Attributes: map[string]schema.Attribute{
+			"autoscaling_group_name": schema.StringAttribute{
+				Description: "The name of the Auto Scaling group to refresh",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the instance refresh to complete. Must be greater than the total checkpoint delay. Defaults to 3600 seconds (60 minutes) plus the checkpoint delay for each checkpoint below 100 percent",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"preferences": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[refreshPreferencesModel](ctx),
+				Description: "The preferences for the instance refresh",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"auto_rollback": schema.BoolAttribute{
+							Description: "Whether to roll back the Auto Scaling group to its previous configuration if the instance refresh fails. Requires the group to use a launch template",
+							Optional:    true,
+						},
+						"checkpoint_delay": schema.Int64Attribute{
+							Description: "The number of seconds to wait after a checkpoint before continuing. Defaults to 3600",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 172800),
+							},
+						},
+						"checkpoint_percentages": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfInt64Type,
+							ElementType: types.Int64Type,
+							Description: "The ascending percentages of instances replaced at which the instance refresh pauses for checkpoint_delay. The last value must be 100",
+							Optional:    true,
+						},
+						"instance_warmup": schema.Int64Attribute{
+							Description: "The number of seconds until a newly launched instance is configured and ready to use. Defaults to the group's health check grace period",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"max_healthy_percentage": schema.Int64Attribute{
+							Description: "The maximum percentage of the group's desired capacity that can be in service and healthy, or pending, during the instance refresh",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(100, 200),
+							},
+						},
+						"min_healthy_percentage": schema.Int64Attribute{
+							Description: "The minimum percentage of the group's desired capacity that must remain healthy during the instance refresh. Defaults to 90",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 100),
+							},
+						},
+						"skip_matching": schema.BoolAttribute{
+							Description: "Whether to skip replacing instances that already match the group's launch template and instance types",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *instanceRefreshAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config instanceRefreshActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().AutoScalingClient(ctx)
+
+	groupName := config.AutoScalingGroupName.ValueString()
+
+	input := autoscaling.StartInstanceRefreshInput{
+		AutoScalingGroupName: aws.String(groupName),
+		Strategy:             awstypes.RefreshStrategyRolling,
+	}
+
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config.Preferences, &input.Preferences)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The instance refresh pauses for the checkpoint delay at each checkpoint, which the timeout must allow for.
+	checkpointDelays := instanceRefreshCheckpointDelays(input.Preferences)
+	timeout := 60*time.Minute + checkpointDelays
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+
+		if timeout <= checkpointDelays {
+			resp.Diagnostics.AddError("Invalid Timeout", fmt.Sprintf("timeout (%s) must be greater than the total checkpoint delay (%s)", timeout, checkpointDelays))
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Starting Auto Scaling instance refresh action", map[string]any{
+		"autoscaling_group_name": groupName,
+		names.AttrTimeout:        timeout.String(),
+	})
+
+	group, err := findGroupByName(ctx, conn, groupName)
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.AddError("Auto Scaling Group Not Found", fmt.Sprintf("Auto Scaling group %s was not found", groupName))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Describing Auto Scaling group", err.Error())
+		return
+	}
+
+	// "The AutoRollback parameter cannot be set to true when the DesiredConfiguration parameter is empty".
+	if input.Preferences != nil && aws.ToBool(input.Preferences.AutoRollback) {
+		if group.LaunchTemplate == nil && group.MixedInstancesPolicy == nil {
+			resp.Diagnostics.AddError("Auto Rollback Not Supported", fmt.Sprintf("Auto Scaling group %s does not use a launch template, auto_rollback requires a launch template or mixed instances policy", groupName))
+			return
+		}
+
+		input.DesiredConfiguration = &awstypes.DesiredConfiguration{
+			LaunchTemplate:       group.LaunchTemplate,
+			MixedInstancesPolicy: group.MixedInstancesPolicy,
+		}
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting instance refresh of Auto Scaling group %s...", groupName),
+	})
+
+	output, err := conn.StartInstanceRefresh(ctx, &input)
+	if errs.IsA[*awstypes.InstanceRefreshInProgressFault](err) {
+		resp.Diagnostics.AddError("Instance Refresh In Progress", fmt.Sprintf("An instance refresh of Auto Scaling group %s is already in progress", groupName))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Starting Auto Scaling instance refresh", err.Error())
+		return
+	}
+
+	refreshID := aws.ToString(output.InstanceRefreshId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Instance refresh %s started, waiting for completion...", refreshID),
+	})
+
+	var checkpoints []int32
+	if input.Preferences != nil {
+		checkpoints = slices.Sorted(slices.Values(input.Preferences.CheckpointPercentages))
+	}
+	var lastProgress string
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.InstanceRefresh], error) {
+		input := autoscaling.DescribeInstanceRefreshesInput{
+			AutoScalingGroupName: aws.String(groupName),
+			InstanceRefreshIds:   []string{refreshID},
+		}
+
+		refresh, err := findInstanceRefresh(ctx, conn, &input)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.InstanceRefresh]{}, err
+		}
+
+		percentage := aws.ToInt32(refresh.PercentageComplete)
+		for len(checkpoints) > 0 && percentage >= checkpoints[0] {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Instance refresh %s reached checkpoint %d%%", refreshID, checkpoints[0]),
+			})
+			checkpoints = checkpoints[1:]
+		}
+
+		if progress := instanceRefreshProgress(refresh); progress != lastProgress {
+			lastProgress = progress
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Instance refresh %s %s", refreshID, progress),
+			})
+		}
+
+		return actionwait.FetchResult[*awstypes.InstanceRefresh]{Status: actionwait.Status(refresh.Status), Value: refresh}, nil
+	}, actionwait.Options[*awstypes.InstanceRefresh]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(instanceRefreshPollInterval),
+		ProgressInterval: 2 * time.Minute,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.InstanceRefreshStatusSuccessful),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.InstanceRefreshStatusPending),
+			actionwait.Status(awstypes.InstanceRefreshStatusInProgress),
+			actionwait.Status(awstypes.InstanceRefreshStatusBaking),
+			actionwait.Status(awstypes.InstanceRefreshStatusCancelling),
+			actionwait.Status(awstypes.InstanceRefreshStatusRollbackInProgress),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.InstanceRefreshStatusFailed),
+			actionwait.Status(awstypes.InstanceRefreshStatusCancelled),
+			actionwait.Status(awstypes.InstanceRefreshStatusRollbackFailed),
+			actionwait.Status(awstypes.InstanceRefreshStatusRollbackSuccessful),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Instance refresh %s is %s (elapsed %s)", refreshID, fr.Status, meta.Elapsed.Round(time.Second)),
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError("Instance refresh timeout", fmt.Sprintf("Instance refresh %s of Auto Scaling group %s did not complete within %s (last status: %s)", refreshID, groupName, timeout, timeoutErr.LastStatus))
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError("Instance refresh failed", instanceRefreshFailureDetail(result.Value))
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected instance refresh status", err.Error())
+		} else {
+			resp.Diagnostics.AddError("Error waiting for instance refresh", err.Error())
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Instance refresh %s of Auto Scaling group %s completed successfully", refreshID, groupName),
+	})
+
+	tflog.Info(ctx, "Auto Scaling instance refresh action completed successfully", map[string]any{
+		"autoscaling_group_name": groupName,
+		"instance_refresh_id":    refreshID,
+	})
+}
+
+// instanceRefreshProgress describes the status and completion of an instance refresh.
+// instanceRefreshCheckpointDelays returns the total time an instance refresh pauses at its checkpoints.
+// The checkpoint delay defaults to 3600 seconds, and there is no pause at the final 100% checkpoint.
+func instanceRefreshCheckpointDelays(preferences *awstypes.RefreshPreferences) time.Duration {
+	if preferences == nil {
+		return 0
+	}
+
+	delay := 3600 * time.Second
+	if v := preferences.CheckpointDelay; v != nil {
+		delay = time.Duration(aws.ToInt32(v)) * time.Second
+	}
+
+	var n int
+	for _, v := range preferences.CheckpointPercentages {
+		if v < 100 {
+			n++
+		}
+	}
+
+	return time.Duration(n) * delay
+}
+
+func instanceRefreshProgress(refresh *awstypes.InstanceRefresh) string {
+	progress := fmt.Sprintf("is %s: %d%% complete, %d instances to update", refresh.Status, aws.ToInt32(refresh.PercentageComplete), aws.ToInt32(refresh.InstancesToUpdate))
+	if reason := aws.ToString(refresh.StatusReason); reason != "" {
+		progress += fmt.Sprintf(" (%s)", reason)
+	}
+
+	return progress
+}
+
+// instanceRefreshFailureDetail describes why an instance refresh failed or was rolled back.
+func instanceRefreshFailureDetail(refresh *awstypes.InstanceRefresh) string {
+	if refresh == nil {
+		return "Instance refresh failed"
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Instance refresh %s is %s", aws.ToString(refresh.InstanceRefreshId), refresh.Status)
+	if reason := aws.ToString(refresh.StatusReason); reason != "" {
+		fmt.Fprintf(&sb, ": %s", reason)
+	}
+	if v := refresh.RollbackDetails; v != nil {
+		if reason := aws.ToString(v.RollbackReason); reason != "" {
+			fmt.Fprintf(&sb, "\nRollback reason: %s", reason)
+		}
+	}
+
+	return sb.String()
+}
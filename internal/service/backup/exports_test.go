@@ -19,6 +19,7 @@ var (
 	ResourceVaultNotifications      = resourceVaultNotifications
 	ResourceVaultPolicy             = resourceVaultPolicy
 
+	FindBackupJobByID                       = findBackupJobByID     // nosemgrep:ci.backup-in-var-name
 	FindBackupVaultByName                   = findBackupVaultByName // nosemgrep:ci.backup-in-var-name
 	FindFrameworkByName                     = findFrameworkByName
 	FindGlobalSettings                      = findGlobalSettings
@@ -0,0 +1,360 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// startBackupJobPollInterval defines polling cadence for the start backup job action.
+const startBackupJobPollInterval = 30 * time.Second
+
+// @Action(aws_backup_start_backup_job, name="Start Backup Job")
+func newStartBackupJobAction(_ context.Context) (action.ActionWithConfigure, error) { // nosemgrep:ci.backup-in-func-name
+	return &startBackupJobAction{}, nil
+}
+
+var (
+	_ action.Action = (*startBackupJobAction)(nil)
+)
+
+type startBackupJobAction struct {
+	framework.ActionWithModel[startBackupJobActionModel]
+}
+
+type startBackupJobActionModel struct {
+	framework.WithRegionModel
+	BackupOptions         types.Map                                       `tfsdk:"backup_options"`
+	BackupVaultName       types.String                                    `tfsdk:"backup_vault_name"`
+	CompleteWindowMinutes types.Int64                                     `tfsdk:"complete_window_minutes"`
+	IAMRoleARN            fwtypes.ARN                                     `tfsdk:"iam_role_arn"`
+	Lifecycle             fwtypes.ListNestedObjectValueOf[lifecycleModel] `tfsdk:"lifecycle"`
+	RecoveryPointTags     types.Map                                       `tfsdk:"recovery_point_tags"`
+	ResourceARN           fwtypes.ARN                                     `tfsdk:"resource_arn"`
+	StartWindowMinutes    types.Int64                                     `tfsdk:"start_window_minutes"`
+	Timeout               types.Int64                                     `tfsdk:"timeout"`
+}
+
+type lifecycleModel struct {
+	ColdStorageAfter                    types.Int64 `tfsdk:"cold_storage_after"`
+	DeleteAfter                         types.Int64 `tfsdk:"delete_after"`
+	OptInToArchiveForSupportedResources types.Bool  `tfsdk:"opt_in_to_archive_for_supported_resources"`
+}
+
+func (a *startBackupJobAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an on-demand AWS Backup job for a resource and waits for it to complete, reporting the bytes transferred and the created recovery point.",
+		Attributes: map[string]schema.Attribute{
+			"backup_options": schema.MapAttribute{
+				ElementType: types.StringType,
+				Description: "Backup options for the resource, such as WindowsVSS for Windows VSS backups",
+				Optional:    true,
+			},
+			"backup_vault_name": schema.StringAttribute{
+				Description: "The name of the backup vault to store the recovery point in",
+				Required:    true,
+			},
+			"complete_window_minutes": schema.Int64Attribute{
+				Description: "The number of minutes after the backup job starts within which it must complete before it is canceled by AWS Backup",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			names.AttrIAMRoleARN: schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of the IAM role that AWS Backup uses to create the recovery point",
+				Required:    true,
+			},
+			"recovery_point_tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Description: "Tags to assign to the created recovery point",
+				Optional:    true,
+			},
+			names.AttrResourceARN: schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of the resource to back up",
+				Required:    true,
+			},
+			"start_window_minutes": schema.Int64Attribute{
+				Description: "The number of minutes after the backup job is scheduled within which it must start before it is canceled by AWS Backup. Must be at least 60",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the backup job to complete. Defaults to 7200 seconds (120 minutes)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"lifecycle": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[lifecycleModel](ctx),
+				Description: "The lifecycle of the created recovery point, which controls when it transitions to cold storage and when it expires",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cold_storage_after": schema.Int64Attribute{
+							Description: "The number of days after creation that the recovery point is moved to cold storage",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"delete_after": schema.Int64Attribute{
+							Description: "The number of days after creation that the recovery point is deleted. Must be at least 90 days greater than cold_storage_after",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"opt_in_to_archive_for_supported_resources": schema.BoolAttribute{
+							Description: "Whether the recovery point is moved to archive storage for supported resource types",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *startBackupJobAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startBackupJobActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().BackupClient(ctx)
+
+	vaultName := config.BackupVaultName.ValueString()
+	resourceARN := config.ResourceARN.ValueString()
+
+	timeout := 120 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting Backup start backup job action", map[string]any{
+		"backup_vault_name":   vaultName,
+		names.AttrResourceARN: resourceARN,
+		names.AttrTimeout:     timeout.String(),
+	})
+
+	if _, err := findVaultByName(ctx, conn, vaultName); err != nil {
+		if tfresource.NotFound(err) {
+			resp.Diagnostics.AddError("Backup Vault Not Found", fmt.Sprintf("Backup vault %s was not found", vaultName))
+			return
+		}
+
+		resp.Diagnostics.AddError("Describing Backup vault", err.Error())
+		return
+	}
+
+	input := backup.StartBackupJobInput{
+		BackupVaultName:       aws.String(vaultName),
+		CompleteWindowMinutes: fwflex.Int64FromFramework(ctx, config.CompleteWindowMinutes),
+		IamRoleArn:            config.IAMRoleARN.ValueStringPointer(),
+		ResourceArn:           aws.String(resourceARN),
+		StartWindowMinutes:    fwflex.Int64FromFramework(ctx, config.StartWindowMinutes),
+	}
+	if v := fwflex.ExpandFrameworkStringValueMap(ctx, config.BackupOptions); len(v) > 0 {
+		input.BackupOptions = v
+	}
+	if v := fwflex.ExpandFrameworkStringValueMap(ctx, config.RecoveryPointTags); len(v) > 0 {
+		input.RecoveryPointTags = v
+	}
+
+	lifecycle, diags := config.Lifecycle.ToPtr(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if lifecycle != nil {
+		input.Lifecycle = lifecycle.expand()
+	}
+
+	output, err := conn.StartBackupJob(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError("Starting Backup job", fmt.Sprintf("Could not start backup job for %s: %s", resourceARN, err))
+		return
+	}
+
+	jobID := aws.ToString(output.BackupJobId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Backup job %s started for %s", jobID, resourceARN),
+	})
+
+	var lastProgress string
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*backup.DescribeBackupJobOutput], error) {
+		job, err := findBackupJobByID(ctx, conn, jobID)
+		if err != nil {
+			return actionwait.FetchResult[*backup.DescribeBackupJobOutput]{}, err
+		}
+
+		if v := backupJobProgressMessage(job); v != lastProgress {
+			lastProgress = v
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: v,
+			})
+		}
+
+		return actionwait.FetchResult[*backup.DescribeBackupJobOutput]{Status: actionwait.Status(job.State), Value: job}, nil
+	}, actionwait.Options[*backup.DescribeBackupJobOutput]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startBackupJobPollInterval),
+		ProgressInterval: 5 * time.Minute,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.BackupJobStateCompleted),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.BackupJobStateCreated),
+			actionwait.Status(awstypes.BackupJobStatePending),
+			actionwait.Status(awstypes.BackupJobStateRunning),
+			actionwait.Status(awstypes.BackupJobStateAborting),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.BackupJobStateAborted),
+			actionwait.Status(awstypes.BackupJobStateExpired),
+			actionwait.Status(awstypes.BackupJobStateFailed),
+			actionwait.Status(awstypes.BackupJobStatePartial),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Backup job %s is %s (elapsed %s)", jobID, fr.Status, meta.Elapsed.Round(time.Second)),
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError("Backup job timeout", fmt.Sprintf("Backup job %s did not complete within %s (last state: %s)", jobID, timeout, timeoutErr.LastStatus))
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(fmt.Sprintf("Backup job %s", strings.ToLower(string(failureErr.Status))), jobStatusDetail("Backup", jobID, string(failureErr.Status), result.Value.StatusMessage))
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected backup job state", err.Error())
+		} else {
+			resp.Diagnostics.AddError("Error waiting for backup job", err.Error())
+		}
+		return
+	}
+
+	recoveryPointARN := aws.ToString(result.Value.RecoveryPointArn)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Backup job %s completed: created recovery point %s (%d bytes)", jobID, recoveryPointARN, aws.ToInt64(result.Value.BackupSizeInBytes)),
+	})
+
+	tflog.Info(ctx, "Backup start backup job action completed successfully", map[string]any{
+		"backup_job_id":      jobID,
+		"recovery_point_arn": recoveryPointARN,
+		"backup_size_bytes":  aws.ToInt64(result.Value.BackupSizeInBytes),
+	})
+}
+
+func (m *lifecycleModel) expand() *awstypes.Lifecycle {
+	return &awstypes.Lifecycle{
+		DeleteAfterDays:                     m.DeleteAfter.ValueInt64Pointer(),
+		MoveToColdStorageAfterDays:          m.ColdStorageAfter.ValueInt64Pointer(),
+		OptInToArchiveForSupportedResources: m.OptInToArchiveForSupportedResources.ValueBoolPointer(),
+	}
+}
+
+func findBackupJobByID(ctx context.Context, conn *backup.Client, id string) (*backup.DescribeBackupJobOutput, error) { // nosemgrep:ci.backup-in-func-name
+	input := backup.DescribeBackupJobInput{
+		BackupJobId: aws.String(id),
+	}
+
+	output, err := conn.DescribeBackupJob(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return output, nil
+}
+
+func backupJobProgressMessage(job *backup.DescribeBackupJobOutput) string { // nosemgrep:ci.backup-in-func-name
+	message := fmt.Sprintf("Backup job %s is %s", aws.ToString(job.BackupJobId), job.State)
+
+	var details []string
+	if v := aws.ToString(job.PercentDone); v != "" {
+		details = append(details, jobPercentDone(v)+" done")
+	}
+	if v := job.BytesTransferred; v != nil {
+		details = append(details, fmt.Sprintf("%d bytes transferred", aws.ToInt64(v)))
+	}
+	if len(details) > 0 {
+		message += fmt.Sprintf(" (%s)", strings.Join(details, ", "))
+	}
+
+	return message
+}
+
+// jobStatusDetail describes why a backup or restore job did not complete, including
+// the status message reported by AWS Backup.
+func jobStatusDetail(jobType, jobID, status string, statusMessage *string) string {
+	detail := fmt.Sprintf("%s job %s is %s", jobType, jobID, status)
+	if v := aws.ToString(statusMessage); v != "" {
+		detail += ": " + v
+	}
+
+	return detail
+}
+
+// jobPercentDone formats the estimated percentage complete of a backup or restore job,
+// which AWS Backup reports with or without a percent sign.
+func jobPercentDone(v string) string {
+	if strings.HasSuffix(v, "%") {
+		return v
+	}
+
+	return v + "%"
+}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package backup_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBackupStartBackupJobAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v backup.DescribeBackupVaultOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_backup_vault.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckVaultDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartBackupJobActionConfig_basic(rName),
+			},
+			{
+				// The vault's recovery point count is read on refresh.
+				Config: testAccStartBackupJobActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVaultExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "recovery_points", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBackupStartBackupJobAction_vaultNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartBackupJobActionConfig_vaultNotFound(),
+				ExpectError: regexache.MustCompile(`Backup Vault Not Found`),
+			},
+		},
+	})
+}
+
+func testAccStartBackupJobActionConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_backup_vault" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "backup.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "backup" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSBackupServiceRolePolicyForBackup"
+  role       = aws_iam_role.test.name
+}
+
+resource "aws_iam_role_policy_attachment" "restore" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSBackupServiceRolePolicyForRestores"
+  role       = aws_iam_role.test.name
+}
+
+resource "aws_ebs_volume" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  size              = 1
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccStartBackupJobActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStartBackupJobActionConfig_base(rName), `
+action "aws_backup_start_backup_job" "test" {
+  config {
+    backup_vault_name = aws_backup_vault.test.name
+    iam_role_arn      = aws_iam_role.test.arn
+    resource_arn      = aws_ebs_volume.test.arn
+    timeout           = 3600
+
+    lifecycle {
+      delete_after = 7
+    }
+
+    recovery_point_tags = {
+      Name = aws_ebs_volume.test.tags["Name"]
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_backup_start_backup_job.test]
+    }
+  }
+
+  depends_on = [
+    aws_backup_vault.test,
+    aws_ebs_volume.test,
+    aws_iam_role_policy_attachment.backup,
+    aws_iam_role_policy_attachment.restore,
+  ]
+}
+`)
+}
+
+func testAccStartBackupJobActionConfig_vaultNotFound() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+action "aws_backup_start_backup_job" "test" {
+  config {
+    backup_vault_name = "tf-acc-test-not-found"
+    iam_role_arn      = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/service-role/AWSBackupDefaultServiceRole"
+    resource_arn      = "arn:${data.aws_partition.current.partition}:ec2:${data.aws_region.current.region}:${data.aws_caller_identity.current.account_id}:volume/vol-00000000000000000"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_backup_start_backup_job.test]
+    }
+  }
+}
+`
+}
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// startRestoreJobPollInterval defines polling cadence for the start restore job action.
+const startRestoreJobPollInterval = 30 * time.Second
+
+// @Action(aws_backup_start_restore_job, name="Start Restore Job")
+func newStartRestoreJobAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startRestoreJobAction{}, nil
+}
+
+var (
+	_ action.Action = (*startRestoreJobAction)(nil)
+)
+
+type startRestoreJobAction struct {
+	framework.ActionWithModel[startRestoreJobActionModel]
+}
+
+type startRestoreJobActionModel struct {
+	framework.WithRegionModel
+	CopySourceTagsToRestoredResource types.Bool   `tfsdk:"copy_source_tags_to_restored_resource"`
+	IAMRoleARN                       fwtypes.ARN  `tfsdk:"iam_role_arn"`
+	Metadata                         types.Map    `tfsdk:"metadata"`
+	RecoveryPointARN                 fwtypes.ARN  `tfsdk:"recovery_point_arn"`
+	ResourceType                     types.String `tfsdk:"resource_type"`
+	Timeout                          types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *startRestoreJobAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an AWS Backup restore job from a recovery point and waits for it to complete, reporting the restored bytes and the created resource.",
+		Attributes: map[string]schema.Attribute{
+			"copy_source_tags_to_restored_resource": schema.BoolAttribute{
+				Description: "Whether to copy the tags of the backed up resource to the restored resource. Only supported for Amazon DynamoDB and Amazon EFS resources",
+				Optional:    true,
+			},
+			names.AttrIAMRoleARN: schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of the IAM role that AWS Backup uses to create the restored resource",
+				Optional:    true,
+			},
+			"metadata": schema.MapAttribute{
+				ElementType: types.StringType,
+				Description: "Resource type specific restore metadata, such as the name or subnet of the restored resource. The metadata of a recovery point can be retrieved with the GetRecoveryPointRestoreMetadata API",
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"recovery_point_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of the recovery point to restore",
+				Required:    true,
+			},
+			names.AttrResourceType: schema.StringAttribute{
+				Description: "The type of the resource to restore, such as EBS, EC2, EFS, RDS or DynamoDB",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the restore job to complete. Defaults to 7200 seconds (120 minutes)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+	}
+}
+
+func (a *startRestoreJobAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startRestoreJobActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().BackupClient(ctx)
+
+	recoveryPointARN := config.RecoveryPointARN.ValueString()
+
+	timeout := 120 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting Backup start restore job action", map[string]any{
+		"recovery_point_arn": recoveryPointARN,
+		names.AttrTimeout:    timeout.String(),
+	})
+
+	input := backup.StartRestoreJobInput{
+		CopySourceTagsToRestoredResource: config.CopySourceTagsToRestoredResource.ValueBool(),
+		IamRoleArn:                       config.IAMRoleARN.ValueStringPointer(),
+		Metadata:                         fwflex.ExpandFrameworkStringValueMap(ctx, config.Metadata),
+		RecoveryPointArn:                 aws.String(recoveryPointARN),
+		ResourceType:                     config.ResourceType.ValueStringPointer(),
+	}
+
+	output, err := conn.StartRestoreJob(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		resp.Diagnostics.AddError("Recovery Point Not Found", fmt.Sprintf("Backup recovery point %s was not found", recoveryPointARN))
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Starting Backup restore job", fmt.Sprintf("Could not start restore job from %s: %s", recoveryPointARN, err))
+		return
+	}
+
+	jobID := aws.ToString(output.RestoreJobId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Restore job %s started from %s", jobID, recoveryPointARN),
+	})
+
+	var lastProgress string
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*backup.DescribeRestoreJobOutput], error) {
+		job, err := findRestoreJobByID(ctx, conn, jobID)
+		if err != nil {
+			return actionwait.FetchResult[*backup.DescribeRestoreJobOutput]{}, err
+		}
+
+		if v := restoreJobProgressMessage(job); v != lastProgress {
+			lastProgress = v
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: v,
+			})
+		}
+
+		return actionwait.FetchResult[*backup.DescribeRestoreJobOutput]{Status: actionwait.Status(job.Status), Value: job}, nil
+	}, actionwait.Options[*backup.DescribeRestoreJobOutput]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startRestoreJobPollInterval),
+		ProgressInterval: 5 * time.Minute,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.RestoreJobStatusCompleted),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.RestoreJobStatusPending),
+			actionwait.Status(awstypes.RestoreJobStatusRunning),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.RestoreJobStatusAborted),
+			actionwait.Status(awstypes.RestoreJobStatusFailed),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Restore job %s is %s (elapsed %s)", jobID, fr.Status, meta.Elapsed.Round(time.Second)),
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError("Restore job timeout", fmt.Sprintf("Restore job %s did not complete within %s (last status: %s)", jobID, timeout, timeoutErr.LastStatus))
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(fmt.Sprintf("Restore job %s", strings.ToLower(string(failureErr.Status))), jobStatusDetail("Restore", jobID, string(failureErr.Status), result.Value.StatusMessage))
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected restore job status", err.Error())
+		} else {
+			resp.Diagnostics.AddError("Error waiting for restore job", err.Error())
+		}
+		return
+	}
+
+	createdResourceARN := aws.ToString(result.Value.CreatedResourceArn)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Restore job %s completed: created resource %s (%d bytes)", jobID, createdResourceARN, aws.ToInt64(result.Value.BackupSizeInBytes)),
+	})
+
+	tflog.Info(ctx, "Backup start restore job action completed successfully", map[string]any{
+		"restore_job_id":       jobID,
+		"created_resource_arn": createdResourceARN,
+		"backup_size_bytes":    aws.ToInt64(result.Value.BackupSizeInBytes),
+	})
+}
+
+func findRestoreJobByID(ctx context.Context, conn *backup.Client, id string) (*backup.DescribeRestoreJobOutput, error) {
+	input := backup.DescribeRestoreJobInput{
+		RestoreJobId: aws.String(id),
+	}
+
+	output, err := conn.DescribeRestoreJob(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return output, nil
+}
+
+func restoreJobProgressMessage(job *backup.DescribeRestoreJobOutput) string {
+	message := fmt.Sprintf("Restore job %s is %s", aws.ToString(job.RestoreJobId), job.Status)
+
+	var details []string
+	if v := aws.ToString(job.PercentDone); v != "" {
+		details = append(details, jobPercentDone(v)+" done")
+	}
+	if v := aws.ToInt64(job.ExpectedCompletionTimeMinutes); v > 0 {
+		details = append(details, fmt.Sprintf("expected to complete in %d minutes", v))
+	}
+	if len(details) > 0 {
+		message += fmt.Sprintf(" (%s)", strings.Join(details, ", "))
+	}
+
+	return message
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package backup_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBackupStartRestoreJobAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckVaultDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartBackupJobActionConfig_basic(rName),
+			},
+			{
+				Config: testAccStartRestoreJobActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestoredVolumesDeleted(ctx, "aws_ebs_volume.test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBackupStartRestoreJobAction_recoveryPointNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartRestoreJobActionConfig_recoveryPointNotFound(),
+				ExpectError: regexache.MustCompile(`Recovery Point Not Found`),
+			},
+		},
+	})
+}
+
+// testAccCheckRestoredVolumesDeleted verifies that a restore job completed for the named EBS volume
+// and deletes the restored volumes, which are not managed by Terraform.
+func testAccCheckRestoredVolumesDeleted(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client := acctest.Provider.Meta().(*conns.AWSClient)
+		conn := client.BackupClient(ctx)
+
+		input := backup.ListRestoreJobsByProtectedResourceInput{
+			ResourceArn: aws.String(rs.Primary.Attributes[names.AttrARN]),
+		}
+		var createdResourceARNs []string
+
+		pages := backup.NewListRestoreJobsByProtectedResourcePaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return err
+			}
+
+			for _, v := range page.RestoreJobs {
+				if v.Status == awstypes.RestoreJobStatusCompleted && v.CreatedResourceArn != nil {
+					createdResourceARNs = append(createdResourceARNs, aws.ToString(v.CreatedResourceArn))
+				}
+			}
+		}
+
+		if len(createdResourceARNs) == 0 {
+			return fmt.Errorf("no completed Backup Restore Job found for %s", rs.Primary.ID)
+		}
+
+		ec2Conn := client.EC2Client(ctx)
+
+		for _, v := range createdResourceARNs {
+			volumeARN, err := arn.Parse(v)
+
+			if err != nil {
+				return err
+			}
+
+			input := ec2.DeleteVolumeInput{
+				VolumeId: aws.String(strings.TrimPrefix(volumeARN.Resource, "volume/")),
+			}
+
+			if _, err := ec2Conn.DeleteVolume(ctx, &input); err != nil {
+				return fmt.Errorf("deleting restored EBS Volume (%s): %w", v, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccStartRestoreJobActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStartBackupJobActionConfig_basic(rName), `
+# The EBS snapshot is the recovery point created by the backup job.
+data "aws_ebs_snapshot" "test" {
+  most_recent = true
+  owners      = ["self"]
+
+  filter {
+    name   = "tag:Name"
+    values = [aws_ebs_volume.test.tags["Name"]]
+  }
+
+  depends_on = [terraform_data.trigger]
+}
+
+action "aws_backup_start_restore_job" "test" {
+  config {
+    iam_role_arn       = aws_iam_role.test.arn
+    recovery_point_arn = data.aws_ebs_snapshot.test.arn
+    resource_type      = "EBS"
+    timeout            = 3600
+
+    metadata = {
+      availabilityZone = aws_ebs_volume.test.availability_zone
+      volumeSize       = "1"
+      volumeType       = "gp3"
+    }
+  }
+}
+
+resource "terraform_data" "restore" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_backup_start_restore_job.test]
+    }
+  }
+
+  depends_on = [data.aws_ebs_snapshot.test]
+}
+`)
+}
+
+func testAccStartRestoreJobActionConfig_recoveryPointNotFound() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+action "aws_backup_start_restore_job" "test" {
+  config {
+    iam_role_arn       = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/service-role/AWSBackupDefaultServiceRole"
+    recovery_point_arn = "arn:${data.aws_partition.current.partition}:ec2:${data.aws_region.current.region}::snapshot/snap-00000000000000000"
+
+    metadata = {
+      availabilityZone = "${data.aws_region.current.region}a"
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_backup_start_restore_job.test]
+    }
+  }
+}
+`
+}
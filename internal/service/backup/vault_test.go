@@ -21,7 +21,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
-	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfbackup "github.com/hashicorp/terraform-provider-aws/internal/service/backup"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -282,34 +281,9 @@ func testAccPreCheck(ctx context.Context, t *testing.T) {
 	}
 }
 
-func findJobByID(ctx context.Context, conn *backup.Client, id string) (*backup.DescribeBackupJobOutput, error) {
-	input := &backup.DescribeBackupJobInput{
-		BackupJobId: aws.String(id),
-	}
-
-	output, err := conn.DescribeBackupJob(ctx, input)
-
-	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
-		return nil, &retry.NotFoundError{
-			LastError:   err,
-			LastRequest: input,
-		}
-	}
-
-	if err != nil {
-		return nil, err
-	}
-
-	if output == nil {
-		return nil, tfresource.NewEmptyResultError(input)
-	}
-
-	return output, nil
-}
-
 func statusJobState(ctx context.Context, conn *backup.Client, id string) retry.StateRefreshFunc {
 	return func() (any, string, error) {
-		output, err := findJobByID(ctx, conn, id)
+		output, err := tfbackup.FindBackupJobByID(ctx, conn, id)
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
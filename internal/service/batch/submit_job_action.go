@@ -0,0 +1,519 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/batch/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// submitJobPollInterval defines polling cadence for the submit job action.
+const submitJobPollInterval = 15 * time.Second
+
+// submitJobMaxFailedChildJobs is the maximum number of an array job's failed child jobs reported when the job fails.
+const submitJobMaxFailedChildJobs = 5
+
+// @Action(aws_batch_submit_job, name="Submit Job")
+func newSubmitJobAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &submitJobAction{}, nil
+}
+
+var (
+	_ action.Action = (*submitJobAction)(nil)
+)
+
+type submitJobAction struct {
+	framework.ActionWithModel[submitJobActionModel]
+}
+
+type submitJobActionModel struct {
+	framework.WithRegionModel
+	ArraySize             types.Int64                                         `tfsdk:"array_size"`
+	ContainerOverrides    jsontypes.Normalized                                `tfsdk:"container_overrides"`
+	DependsOnJobIDs       fwtypes.ListOfString                                `tfsdk:"depends_on_job_ids"`
+	ECSPropertiesOverride jsontypes.Normalized                                `tfsdk:"ecs_properties_override"`
+	EKSPropertiesOverride jsontypes.Normalized                                `tfsdk:"eks_properties_override"`
+	JobDefinition         types.String                                        `tfsdk:"job_definition"`
+	JobName               types.String                                        `tfsdk:"job_name"`
+	JobQueue              types.String                                        `tfsdk:"job_queue"`
+	Parameters            fwtypes.MapOfString                                 `tfsdk:"parameters"`
+	RetryStrategy         fwtypes.ListNestedObjectValueOf[retryStrategyModel] `tfsdk:"retry_strategy"`
+	Timeout               types.Int64                                         `tfsdk:"timeout"`
+}
+
+func (a *submitJobAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Submits a Batch job and waits for it to finish. The action fails if the job fails.",
+		Attributes: map[string]schema.Attribute{
+			"array_size": schema.Int64Attribute{
+				Description: "The size of the array job. If specified, the job is an array job with this many child jobs",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(2, 10000),
+				},
+			},
+			"container_overrides": schema.StringAttribute{
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "A JSON document of container overrides, in the format of the ContainerOverrides API object, for jobs that run on EC2 or Fargate resources",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("ecs_properties_override"),
+						path.MatchRoot("eks_properties_override"),
+					),
+				},
+			},
+			"depends_on_job_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Description: "The IDs of jobs that must succeed before this job runs",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(20),
+				},
+			},
+			"ecs_properties_override": schema.StringAttribute{
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "A JSON document of ECS properties overrides, in the format of the EcsPropertiesOverride API object",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("eks_properties_override")),
+				},
+			},
+			"eks_properties_override": schema.StringAttribute{
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "A JSON document of EKS properties overrides, in the format of the EksPropertiesOverride API object",
+				Optional:    true,
+			},
+			"job_definition": schema.StringAttribute{
+				Description: "The name, name:revision or ARN of the job definition used by the job",
+				Required:    true,
+			},
+			"job_name": schema.StringAttribute{
+				Description: "The name of the job",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"job_queue": schema.StringAttribute{
+				Description: "The name or ARN of the job queue to submit the job to",
+				Required:    true,
+			},
+			names.AttrParameters: schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Description: "The parameter substitution placeholders to set in the job definition",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the job to finish. Defaults to 3600 seconds (60 minutes)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"retry_strategy": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[retryStrategyModel](ctx),
+				Description: "The retry strategy for the job. Overrides the job definition",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"attempts": schema.Int64Attribute{
+							Description: "The number of times to move a job to the RUNNABLE status",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 10),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"evaluate_on_exit": schema.ListNestedBlock{
+							CustomType:  fwtypes.NewListNestedObjectTypeOf[evaluateOnExitModel](ctx),
+							Description: "The conditions under which the job is retried or failed",
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(5),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrAction: schema.StringAttribute{
+										Description: "The action to take if all conditions are met. Valid values are RETRY and EXIT",
+										Required:    true,
+										Validators: []validator.String{
+											enum.FrameworkValidateIgnoreCase[awstypes.RetryAction](),
+										},
+									},
+									"on_exit_code": schema.StringAttribute{
+										Description: "A glob pattern to match against the decimal exit code returned for a job",
+										Optional:    true,
+									},
+									"on_reason": schema.StringAttribute{
+										Description: "A glob pattern to match against the reason returned for a job",
+										Optional:    true,
+									},
+									"on_status_reason": schema.StringAttribute{
+										Description: "A glob pattern to match against the status reason returned for a job",
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *submitJobAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config submitJobActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().BatchClient(ctx)
+
+	jobName := config.JobName.ValueString()
+	jobQueue := config.JobQueue.ValueString()
+
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting Batch submit job action", map[string]any{
+		"job_name":        jobName,
+		"job_queue":       jobQueue,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	input := batch.SubmitJobInput{
+		JobDefinition: fwflex.StringFromFramework(ctx, config.JobDefinition),
+		JobName:       aws.String(jobName),
+		JobQueue:      aws.String(jobQueue),
+		Parameters:    fwflex.ExpandFrameworkStringValueMap(ctx, config.Parameters),
+	}
+
+	if !config.ArraySize.IsNull() {
+		input.ArrayProperties = &awstypes.ArrayProperties{
+			Size: fwflex.Int32FromFrameworkInt64(ctx, config.ArraySize),
+		}
+	}
+
+	for _, jobID := range fwflex.ExpandFrameworkStringValueList(ctx, config.DependsOnJobIDs) {
+		input.DependsOn = append(input.DependsOn, awstypes.JobDependency{
+			JobId: aws.String(jobID),
+		})
+	}
+
+	if !config.ContainerOverrides.IsNull() {
+		input.ContainerOverrides = &awstypes.ContainerOverrides{}
+		if err := tfjson.DecodeFromString(config.ContainerOverrides.ValueString(), input.ContainerOverrides); err != nil {
+			resp.Diagnostics.AddError("Invalid container_overrides", err.Error())
+			return
+		}
+	}
+
+	if !config.ECSPropertiesOverride.IsNull() {
+		input.EcsPropertiesOverride = &awstypes.EcsPropertiesOverride{}
+		if err := tfjson.DecodeFromString(config.ECSPropertiesOverride.ValueString(), input.EcsPropertiesOverride); err != nil {
+			resp.Diagnostics.AddError("Invalid ecs_properties_override", err.Error())
+			return
+		}
+	}
+
+	if !config.EKSPropertiesOverride.IsNull() {
+		input.EksPropertiesOverride = &awstypes.EksPropertiesOverride{}
+		if err := tfjson.DecodeFromString(config.EKSPropertiesOverride.ValueString(), input.EksPropertiesOverride); err != nil {
+			resp.Diagnostics.AddError("Invalid eks_properties_override", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config.RetryStrategy, &input.RetryStrategy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Submitting job %s to queue %s...", jobName, jobQueue),
+	})
+
+	output, err := conn.SubmitJob(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError("Submitting Batch job", err.Error())
+		return
+	}
+
+	jobID := aws.ToString(output.JobId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Job %s submitted, waiting for completion...", jobID),
+	})
+
+	var lastProgress string
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.JobDetail], error) {
+		job, err := findJobByID(ctx, conn, jobID)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.JobDetail]{}, err
+		}
+
+		if progress := jobProgress(job); progress != lastProgress {
+			lastProgress = progress
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Job %s %s", jobID, progress),
+			})
+		}
+
+		return actionwait.FetchResult[*awstypes.JobDetail]{Status: actionwait.Status(job.Status), Value: job}, nil
+	}, actionwait.Options[*awstypes.JobDetail]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(submitJobPollInterval),
+		ProgressInterval: 2 * time.Minute,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.JobStatusSucceeded),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.JobStatusSubmitted),
+			actionwait.Status(awstypes.JobStatusPending),
+			actionwait.Status(awstypes.JobStatusRunnable),
+			actionwait.Status(awstypes.JobStatusStarting),
+			actionwait.Status(awstypes.JobStatusRunning),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.JobStatusFailed),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Job %s is %s (elapsed %s)", jobID, fr.Status, meta.Elapsed.Round(time.Second)),
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError("Job timeout", fmt.Sprintf("Job %s did not finish within %s (last status: %s)", jobID, timeout, timeoutErr.LastStatus))
+		} else if errors.As(err, &failureErr) {
+			var children []awstypes.JobDetail
+			if job := result.Value; job != nil && job.ArrayProperties != nil {
+				children, err = findJobsByArrayJobIDAndStatus(ctx, conn, jobID, awstypes.JobStatusFailed, submitJobMaxFailedChildJobs)
+				if err != nil {
+					tflog.Warn(ctx, "Listing failed Batch child jobs", map[string]any{
+						"job_id": jobID,
+						"error":  err.Error(),
+					})
+				}
+			}
+			resp.Diagnostics.AddError("Job failed", jobFailureDetail(result.Value, children))
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected job status", err.Error())
+		} else {
+			resp.Diagnostics.AddError("Error waiting for job", err.Error())
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Job %s (%s) succeeded", jobID, jobName),
+	})
+
+	tflog.Info(ctx, "Batch submit job action completed successfully", map[string]any{
+		"job_id":   jobID,
+		"job_name": jobName,
+	})
+}
+
+func findJobByID(ctx context.Context, conn *batch.Client, id string) (*awstypes.JobDetail, error) {
+	input := batch.DescribeJobsInput{
+		Jobs: []string{id},
+	}
+
+	output, err := conn.DescribeJobs(ctx, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.Jobs)
+}
+
+// findJobsByArrayJobIDAndStatus returns up to limit child jobs of the specified array job with the specified status.
+func findJobsByArrayJobIDAndStatus(ctx context.Context, conn *batch.Client, arrayJobID string, status awstypes.JobStatus, limit int) ([]awstypes.JobDetail, error) {
+	input := batch.ListJobsInput{
+		ArrayJobId: aws.String(arrayJobID),
+		JobStatus:  status,
+	}
+	var ids []string
+
+	pages := batch.NewListJobsPaginator(conn, &input)
+	for pages.HasMorePages() && len(ids) < limit {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.JobSummaryList {
+			if len(ids) == limit {
+				break
+			}
+			ids = append(ids, aws.ToString(v.JobId))
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	// Job summaries don't include the attempts' log streams.
+	describeInput := batch.DescribeJobsInput{
+		Jobs: ids,
+	}
+
+	output, err := conn.DescribeJobs(ctx, &describeInput)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Jobs, nil
+}
+
+// jobProgress describes the status of a job and, for array jobs, the status of its child jobs.
+func jobProgress(job *awstypes.JobDetail) string {
+	progress := fmt.Sprintf("is %s", job.Status)
+	if reason := aws.ToString(job.StatusReason); reason != "" {
+		progress += fmt.Sprintf(" (%s)", reason)
+	}
+
+	if v := job.ArrayProperties; v != nil && len(v.StatusSummary) > 0 {
+		var summary []string
+		for _, status := range slices.Sorted(maps.Keys(v.StatusSummary)) {
+			summary = append(summary, fmt.Sprintf("%s: %d", status, v.StatusSummary[status]))
+		}
+		progress += fmt.Sprintf(", child jobs %s", strings.Join(summary, ", "))
+	}
+
+	return progress
+}
+
+// jobFailureDetail describes why a job failed, including the exit code and log stream of its last attempt's containers.
+// For array jobs, the specified failed child jobs are described in the same way.
+func jobFailureDetail(job *awstypes.JobDetail, children []awstypes.JobDetail) string {
+	if job == nil {
+		return "Job failed"
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Job %s (%s) %s", aws.ToString(job.JobId), aws.ToString(job.JobName), jobProgress(job))
+	writeJobAttemptFailureDetail(&sb, job)
+
+	for _, child := range children {
+		fmt.Fprintf(&sb, "\n\nChild job %s", aws.ToString(child.JobId))
+		if v := child.ArrayProperties; v != nil && v.Index != nil {
+			fmt.Fprintf(&sb, " (index %d)", aws.ToInt32(v.Index))
+		}
+		fmt.Fprintf(&sb, " %s", jobProgress(&child))
+		writeJobAttemptFailureDetail(&sb, &child)
+	}
+
+	if v := job.ArrayProperties; v != nil {
+		if n := int(v.StatusSummary[string(awstypes.JobStatusFailed)]); n > len(children) && len(children) > 0 {
+			fmt.Fprintf(&sb, "\n\n(%d more failed child jobs not shown)", n-len(children))
+		}
+	}
+
+	return sb.String()
+}
+
+// writeJobAttemptFailureDetail writes the exit code and log stream of the containers of a job's last attempt.
+func writeJobAttemptFailureDetail(sb *strings.Builder, job *awstypes.JobDetail) {
+	// The number of attempts is only known if the job has a retry strategy.
+	var attempts string
+	if v := job.RetryStrategy; v != nil && v.Attempts != nil {
+		attempts = fmt.Sprintf(" of %d", aws.ToInt32(v.Attempts))
+	}
+
+	if n := len(job.Attempts); n > 0 {
+		attempt := job.Attempts[n-1]
+		fmt.Fprintf(sb, "\nAttempt %d%s", n, attempts)
+		if reason := aws.ToString(attempt.StatusReason); reason != "" {
+			fmt.Fprintf(sb, ": %s", reason)
+		}
+
+		if v := attempt.Container; v != nil {
+			writeJobContainerFailureDetail(sb, "container", v.ExitCode, v.Reason, v.LogStreamName)
+		}
+		for _, task := range attempt.TaskProperties {
+			for _, v := range task.Containers {
+				writeJobContainerFailureDetail(sb, fmt.Sprintf("container %s", aws.ToString(v.Name)), v.ExitCode, v.Reason, v.LogStreamName)
+			}
+		}
+	}
+
+	if n := len(job.EksAttempts); n > 0 {
+		attempt := job.EksAttempts[n-1]
+		fmt.Fprintf(sb, "\nEKS attempt %d%s on pod %s", n, attempts, aws.ToString(attempt.PodName))
+		if reason := aws.ToString(attempt.StatusReason); reason != "" {
+			fmt.Fprintf(sb, ": %s", reason)
+		}
+
+		for _, v := range attempt.Containers {
+			writeJobContainerFailureDetail(sb, fmt.Sprintf("container %s", aws.ToString(v.Name)), v.ExitCode, v.Reason, nil)
+		}
+	}
+}
+
+func writeJobContainerFailureDetail(sb *strings.Builder, name string, exitCode *int32, reason, logStreamName *string) {
+	fmt.Fprintf(sb, "\n  %s", name)
+	if exitCode != nil {
+		fmt.Fprintf(sb, " exited with code %d", aws.ToInt32(exitCode))
+	}
+	if v := aws.ToString(reason); v != "" {
+		fmt.Fprintf(sb, ": %s", v)
+	}
+	if v := aws.ToString(logStreamName); v != "" {
+		fmt.Fprintf(sb, " (log stream %s)", v)
+	}
+}
@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batch_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBatchSubmitJobAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckJobQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubmitJobActionConfig_basic(rName, "exit 0"),
+			},
+		},
+	})
+}
+
+func TestAccBatchSubmitJobAction_containerOverrides(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckJobQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSubmitJobActionConfig_containerOverrides(rName, "exit 3"),
+				ExpectError: regexache.MustCompile(`(?s)Job failed.*is FAILED.*exited with code 3`),
+			},
+		},
+	})
+}
+
+func TestAccBatchSubmitJobAction_failed(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckJobQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSubmitJobActionConfig_basic(rName, "exit 1"),
+				ExpectError: regexache.MustCompile(`(?s)Job failed.*is FAILED.*exited with code 1`),
+			},
+		},
+	})
+}
+
+func testAccSubmitJobActionConfig_base(rName, command string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "ecs_tasks" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["ecs-tasks.${data.aws_partition.current.dns_suffix}"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.ecs_tasks.json
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AmazonECSTaskExecutionRolePolicy"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  route {
+    cidr_block = "0.0.0.0/0"
+    gateway_id = aws_internet_gateway.test.id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.1.1.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table_association" "test" {
+  route_table_id = aws_route_table.test.id
+  subnet_id      = aws_subnet.test.id
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_batch_compute_environment" "test" {
+  name = %[1]q
+  type = "MANAGED"
+
+  compute_resources {
+    max_vcpus          = 1
+    security_group_ids = [aws_security_group.test.id]
+    subnets            = [aws_subnet.test.id]
+    type               = "FARGATE"
+  }
+
+  depends_on = [aws_route_table_association.test]
+}
+
+resource "aws_batch_job_queue" "test" {
+  name     = %[1]q
+  priority = 1
+  state    = "ENABLED"
+
+  compute_environment_order {
+    compute_environment = aws_batch_compute_environment.test.arn
+    order               = 1
+  }
+}
+
+resource "aws_batch_job_definition" "test" {
+  name = %[1]q
+  type = "container"
+
+  platform_capabilities = ["FARGATE"]
+
+  container_properties = jsonencode({
+    command          = ["sh", "-c", %[2]q]
+    image            = "public.ecr.aws/docker/library/busybox:latest"
+    executionRoleArn = aws_iam_role.test.arn
+    networkConfiguration = {
+      assignPublicIp = "ENABLED"
+    }
+    resourceRequirements = [
+      { type = "VCPU", value = "0.25" },
+      { type = "MEMORY", value = "512" },
+    ]
+  })
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, command))
+}
+
+func testAccSubmitJobActionConfig_basic(rName, command string) string {
+	return acctest.ConfigCompose(testAccSubmitJobActionConfig_base(rName, command), fmt.Sprintf(`
+action "aws_batch_submit_job" "test" {
+  config {
+    job_definition = aws_batch_job_definition.test.arn
+    job_name       = %[1]q
+    job_queue      = aws_batch_job_queue.test.arn
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_batch_submit_job.test]
+    }
+  }
+
+  depends_on = [aws_batch_job_queue.test, aws_batch_job_definition.test]
+}
+`, rName))
+}
+
+func testAccSubmitJobActionConfig_containerOverrides(rName, command string) string {
+	return acctest.ConfigCompose(testAccSubmitJobActionConfig_base(rName, "exit 0"), fmt.Sprintf(`
+action "aws_batch_submit_job" "test" {
+  config {
+    job_definition = aws_batch_job_definition.test.arn
+    job_name       = %[1]q
+    job_queue      = aws_batch_job_queue.test.arn
+
+    container_overrides = jsonencode({
+      command = ["sh", "-c", %[2]q]
+    })
+
+    retry_strategy {
+      attempts = 1
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_batch_submit_job.test]
+    }
+  }
+
+  depends_on = [aws_batch_job_queue.test, aws_batch_job_definition.test]
+}
+`, rName, command))
+}
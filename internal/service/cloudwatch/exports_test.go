@@ -12,6 +12,7 @@ var (
 	ResourceContributorInsightRule        = newContributorInsightRuleResource
 	ResourceContributorManagedInsightRule = newContributorManagedInsightRuleResource
 
+	FindAlarmActionHistoryItems                                = findAlarmActionHistoryItems
 	FindCompositeAlarmByName                                   = findCompositeAlarmByName
 	FindDashboardByName                                        = findDashboardByName
 	FindMetricAlarmByName                                      = findMetricAlarmByName
@@ -0,0 +1,378 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// setAlarmStatePollInterval defines polling cadence for the set alarm state action.
+const setAlarmStatePollInterval = 10 * time.Second
+
+// setAlarmStateHistoryTimeout is how long the set alarm state action waits for alarm actions to be recorded in the alarm's history.
+const setAlarmStateHistoryTimeout = 1 * time.Minute
+
+// Synthetic statuses describing the progress of an alarm state change.
+const (
+	alarmStateStatusSetting  = "SETTING"
+	alarmStateStatusSet      = "SET"
+	alarmStateStatusReverted = "REVERTED"
+)
+
+// Synthetic statuses describing whether alarm actions have been recorded in the alarm's history.
+const (
+	alarmHistoryStatusPending  = "PENDING"
+	alarmHistoryStatusRecorded = "RECORDED"
+)
+
+// @Action(aws_cloudwatch_set_alarm_state, name="Set Alarm State")
+func newSetAlarmStateAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &setAlarmStateAction{}, nil
+}
+
+var (
+	_ action.Action = (*setAlarmStateAction)(nil)
+)
+
+type setAlarmStateAction struct {
+	framework.ActionWithModel[setAlarmStateActionModel]
+}
+
+type setAlarmStateActionModel struct {
+	framework.WithRegionModel
+	AlarmName       types.String                            `tfsdk:"alarm_name"`
+	StateReason     types.String                            `tfsdk:"state_reason"`
+	StateReasonData jsontypes.Normalized                    `tfsdk:"state_reason_data"`
+	StateValue      fwtypes.StringEnum[awstypes.StateValue] `tfsdk:"state_value"`
+	Timeout         types.Int64                             `tfsdk:"timeout"`
+}
+
+func (a *setAlarmStateAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Temporarily sets the state of a CloudWatch alarm to test its actions, waits for a metric alarm's next evaluation to revert the state, and reports the alarm actions that were executed.",
+		Attributes: map[string]schema.Attribute{
+			"alarm_name": schema.StringAttribute{
+				Description: "The name of the metric or composite alarm",
+				Required:    true,
+			},
+			"state_reason": schema.StringAttribute{
+				Description: "The reason that the alarm state is set, in text format",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1023),
+				},
+			},
+			"state_reason_data": schema.StringAttribute{
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "The reason that the alarm state is set, in JSON format",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(4000),
+				},
+			},
+			"state_value": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.StateValue](),
+				Description: "The state to set the alarm to. Valid values are OK, ALARM and INSUFFICIENT_DATA",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the alarm state to revert. Defaults to 600 seconds (10 minutes)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+	}
+}
+
+func (a *setAlarmStateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config setAlarmStateActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().CloudWatchClient(ctx)
+
+	alarmName := config.AlarmName.ValueString()
+	stateValue := config.StateValue.ValueEnum()
+
+	timeout := 10 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting CloudWatch set alarm state action", map[string]any{
+		"alarm_name":      alarmName,
+		"state_value":     stateValue,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	alarm, err := findAlarmStateByName(ctx, conn, alarmName)
+	if err != nil {
+		if tfresource.NotFound(err) {
+			resp.Diagnostics.AddError("Alarm Not Found", fmt.Sprintf("CloudWatch alarm %s was not found", alarmName))
+			return
+		}
+
+		resp.Diagnostics.AddError("Describing CloudWatch alarm", err.Error())
+		return
+	}
+
+	if alarm.stateValue == stateValue {
+		resp.Diagnostics.AddError("Alarm Already In State", fmt.Sprintf("CloudWatch alarm %s is already in state %s, so setting its state would not execute any alarm actions", alarmName, stateValue))
+		return
+	}
+
+	if !alarm.actionsEnabled {
+		resp.Diagnostics.AddWarning("Alarm Actions Disabled", fmt.Sprintf("Actions are disabled for CloudWatch alarm %s, so no alarm actions will be executed", alarmName))
+	}
+
+	input := cloudwatch.SetAlarmStateInput{
+		AlarmName:   aws.String(alarmName),
+		StateReason: config.StateReason.ValueStringPointer(),
+		StateValue:  stateValue,
+	}
+
+	if !config.StateReasonData.IsNull() {
+		input.StateReasonData = config.StateReasonData.ValueStringPointer()
+	}
+
+	// Alarm actions are recorded after the state is set, so the history is read from this time.
+	setTime := time.Now()
+
+	if _, err := conn.SetAlarmState(ctx, &input); err != nil {
+		resp.Diagnostics.AddError("Setting CloudWatch alarm state", err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Alarm %s state set from %s to %s", alarmName, alarm.stateValue, stateValue),
+	})
+
+	// Metric alarms revert to their evaluated state at their next evaluation. Composite
+	// alarms are only re-evaluated when one of their child alarms changes state.
+	successStates := []actionwait.Status{alarmStateStatusReverted}
+	if alarm.composite {
+		successStates = append(successStates, alarmStateStatusSet)
+	}
+
+	var lastStatus actionwait.Status
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*alarmState], error) {
+		current, err := findAlarmStateByName(ctx, conn, alarmName)
+		if err != nil {
+			return actionwait.FetchResult[*alarmState]{}, err
+		}
+
+		var status actionwait.Status
+		switch {
+		case current.stateValue == stateValue:
+			status = alarmStateStatusSet
+		case current.stateUpdatedTimestamp.After(alarm.stateUpdatedTimestamp):
+			status = alarmStateStatusReverted
+		default:
+			// The state change is not yet visible.
+			status = alarmStateStatusSetting
+		}
+
+		if status != lastStatus {
+			lastStatus = status
+			if status != alarmStateStatusSetting {
+				resp.SendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("Alarm %s is %s", alarmName, current.stateValue),
+				})
+			}
+		}
+
+		return actionwait.FetchResult[*alarmState]{Status: status, Value: current}, nil
+	}, actionwait.Options[*alarmState]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(setAlarmStatePollInterval),
+		ProgressInterval: 2 * time.Minute,
+		SuccessStates:    successStates,
+		TransitionalStates: []actionwait.Status{
+			alarmStateStatusSetting,
+			alarmStateStatusSet,
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Waiting for alarm %s to be re-evaluated (elapsed %s)", alarmName, meta.Elapsed.Round(time.Second)),
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError("Alarm state timeout", fmt.Sprintf("CloudWatch alarm %s did not revert from %s within %s (last status: %s). Check that the alarm's metric is being evaluated and that its evaluated state differs from %s", alarmName, stateValue, timeout, timeoutErr.LastStatus, stateValue))
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected alarm state", err.Error())
+		} else {
+			resp.Diagnostics.AddError("Error waiting for alarm state", err.Error())
+		}
+		return
+	}
+
+	if result.Value.stateValue == stateValue {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Composite alarm %s remains %s until one of its child alarms changes state", alarmName, stateValue),
+		})
+	} else {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Alarm %s reverted to %s", alarmName, result.Value.stateValue),
+		})
+	}
+
+	// Actions are recorded in the alarm's history shortly after they are executed, so the history is polled briefly.
+	// Action history only adds detail to the result, so lookup errors are reported as warnings.
+	history, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[[]awstypes.AlarmHistoryItem], error) {
+		items, err := findAlarmActionHistoryItems(ctx, conn, alarmName, setTime)
+		if err != nil {
+			return actionwait.FetchResult[[]awstypes.AlarmHistoryItem]{}, err
+		}
+
+		status := actionwait.Status(alarmHistoryStatusRecorded)
+		if len(items) == 0 && alarm.actionsEnabled {
+			status = alarmHistoryStatusPending
+		}
+
+		return actionwait.FetchResult[[]awstypes.AlarmHistoryItem]{Status: status, Value: items}, nil
+	}, actionwait.Options[[]awstypes.AlarmHistoryItem]{
+		Timeout:            setAlarmStateHistoryTimeout,
+		Interval:           actionwait.FixedInterval(setAlarmStatePollInterval),
+		SuccessStates:      []actionwait.Status{alarmHistoryStatusRecorded},
+		TransitionalStates: []actionwait.Status{alarmHistoryStatusPending},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		if errors.As(err, &timeoutErr) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("No actions have been recorded yet in the history of alarm %s, they may be recorded later", alarmName),
+			})
+		} else {
+			resp.Diagnostics.AddWarning("Describing CloudWatch alarm history", err.Error())
+		}
+	}
+
+	items := history.Value
+
+	var failed []string
+	for _, item := range items {
+		summary := aws.ToString(item.HistorySummary)
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Alarm %s action at %s: %s", alarmName, aws.ToTime(item.Timestamp).Format(time.RFC3339), summary),
+		})
+
+		if strings.HasPrefix(summary, "Failed") {
+			failed = append(failed, summary)
+		}
+	}
+
+	if len(failed) > 0 {
+		resp.Diagnostics.AddWarning("Alarm Actions Failed", fmt.Sprintf("CloudWatch alarm %s failed to execute %d action(s): %s", alarmName, len(failed), strings.Join(failed, "; ")))
+	}
+
+	tflog.Info(ctx, "CloudWatch set alarm state action completed successfully", map[string]any{
+		"alarm_name": alarmName,
+		"actions":    len(items),
+	})
+}
+
+// alarmState is the state of a metric or composite alarm.
+type alarmState struct {
+	actionsEnabled        bool
+	composite             bool
+	stateUpdatedTimestamp time.Time
+	stateValue            awstypes.StateValue
+}
+
+func findAlarmStateByName(ctx context.Context, conn *cloudwatch.Client, name string) (*alarmState, error) {
+	input := cloudwatch.DescribeAlarmsInput{
+		AlarmNames: []string{name},
+		AlarmTypes: []awstypes.AlarmType{awstypes.AlarmTypeCompositeAlarm, awstypes.AlarmTypeMetricAlarm},
+	}
+
+	output, err := conn.DescribeAlarms(ctx, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	if v := output.MetricAlarms; len(v) > 0 {
+		alarm, err := tfresource.AssertSingleValueResult(v)
+		if err != nil {
+			return nil, err
+		}
+
+		return &alarmState{
+			actionsEnabled:        aws.ToBool(alarm.ActionsEnabled),
+			stateUpdatedTimestamp: aws.ToTime(alarm.StateUpdatedTimestamp),
+			stateValue:            alarm.StateValue,
+		}, nil
+	}
+
+	alarm, err := tfresource.AssertSingleValueResult(output.CompositeAlarms)
+	if err != nil {
+		return nil, err
+	}
+
+	return &alarmState{
+		actionsEnabled:        aws.ToBool(alarm.ActionsEnabled),
+		composite:             true,
+		stateUpdatedTimestamp: aws.ToTime(alarm.StateUpdatedTimestamp),
+		stateValue:            alarm.StateValue,
+	}, nil
+}
+
+func findAlarmActionHistoryItems(ctx context.Context, conn *cloudwatch.Client, name string, startDate time.Time) ([]awstypes.AlarmHistoryItem, error) {
+	input := cloudwatch.DescribeAlarmHistoryInput{
+		AlarmName:       aws.String(name),
+		AlarmTypes:      []awstypes.AlarmType{awstypes.AlarmTypeCompositeAlarm, awstypes.AlarmTypeMetricAlarm},
+		HistoryItemType: awstypes.HistoryItemTypeAction,
+		ScanBy:          awstypes.ScanByTimestampAscending,
+		StartDate:       aws.Time(startDate),
+	}
+	var output []awstypes.AlarmHistoryItem
+
+	pages := cloudwatch.NewDescribeAlarmHistoryPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AlarmHistoryItems...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudwatch "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatch"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudWatchSetAlarmStateAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_metric_alarm.test"
+	start := time.Now()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckMetricAlarmDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSetAlarmStateActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSetAlarmStateActionExecutedActions(ctx, resourceName, start),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchSetAlarmStateAction_alarmNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSetAlarmStateActionConfig_alarmNotFound(),
+				ExpectError: regexache.MustCompile(`Alarm Not Found`),
+			},
+		},
+	})
+}
+
+func testAccCheckSetAlarmStateActionExecutedActions(ctx context.Context, n string, start time.Time) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchClient(ctx)
+
+		output, err := tfcloudwatch.FindAlarmActionHistoryItems(ctx, conn, rs.Primary.ID, start)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output) == 0 {
+			return fmt.Errorf("CloudWatch Metric Alarm %s executed no actions", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSetAlarmStateActionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+# An alarm on a metric that is never published is evaluated as OK.
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_actions       = [aws_sns_topic.test.arn]
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanThreshold"
+  evaluation_periods  = 1
+  metric_name         = %[1]q
+  namespace           = "tf-acc-test"
+  period              = 60
+  statistic           = "Sum"
+  threshold           = 0
+  treat_missing_data  = "notBreaching"
+}
+
+action "aws_cloudwatch_set_alarm_state" "test" {
+  config {
+    alarm_name   = aws_cloudwatch_metric_alarm.test.alarm_name
+    state_reason = "Testing alarm actions"
+    state_value  = "ALARM"
+
+    state_reason_data = jsonencode({
+      test = %[1]q
+    })
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_cloudwatch_set_alarm_state.test]
+    }
+  }
+
+  depends_on = [aws_cloudwatch_metric_alarm.test]
+}
+`, rName)
+}
+
+func testAccSetAlarmStateActionConfig_alarmNotFound() string {
+	return `
+action "aws_cloudwatch_set_alarm_state" "test" {
+  config {
+    alarm_name   = "tf-acc-test-not-found"
+    state_reason = "Testing alarm actions"
+    state_value  = "ALARM"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_cloudwatch_set_alarm_state.test]
+    }
+  }
+}
+`
+}
@@ -0,0 +1,387 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codepipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codepipeline/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// startExecutionPollInterval defines polling cadence for the start execution action.
+const startExecutionPollInterval = 15 * time.Second
+
+// @Action(aws_codepipeline_start_execution, name="Start Execution")
+func newStartExecutionAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startExecutionAction{}, nil
+}
+
+var (
+	_ action.Action = (*startExecutionAction)(nil)
+)
+
+type startExecutionAction struct {
+	framework.ActionWithModel[startExecutionActionModel]
+}
+
+type startExecutionActionModel struct {
+	framework.WithRegionModel
+	PipelineName    types.String                                           `tfsdk:"pipeline_name"`
+	SourceRevisions fwtypes.ListNestedObjectValueOf[sourceRevisionModel]   `tfsdk:"source_revision"`
+	Timeout         types.Int64                                            `tfsdk:"timeout"`
+	Variables       fwtypes.ListNestedObjectValueOf[pipelineVariableModel] `tfsdk:"variable"`
+}
+
+type sourceRevisionModel struct {
+	ActionName    types.String                                    `tfsdk:"action_name"`
+	RevisionType  fwtypes.StringEnum[awstypes.SourceRevisionType] `tfsdk:"revision_type"`
+	RevisionValue types.String                                    `tfsdk:"revision_value"`
+}
+
+type pipelineVariableModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+func (a *startExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts a CodePipeline pipeline execution and waits for it to finish, reporting stage and action state transitions. The action fails if the execution fails, is stopped, cancelled or superseded.",
+		Attributes: map[string]schema.Attribute{
+			"pipeline_name": schema.StringAttribute{
+				Description: "The name of the pipeline to start",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the pipeline execution to finish. Defaults to 3600 seconds (60 minutes)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"source_revision": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[sourceRevisionModel](ctx),
+				Description: "A source revision to use for this execution instead of the latest revision of a source action",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"action_name": schema.StringAttribute{
+							Description: "The name of the source action",
+							Required:    true,
+						},
+						"revision_type": schema.StringAttribute{
+							CustomType:  fwtypes.StringEnumType[awstypes.SourceRevisionType](),
+							Description: "The type of the source revision. Valid values are COMMIT_ID, IMAGE_DIGEST, S3_OBJECT_VERSION_ID and S3_OBJECT_KEY",
+							Required:    true,
+						},
+						"revision_value": schema.StringAttribute{
+							Description: "The source revision, such as a commit ID",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"variable": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[pipelineVariableModel](ctx),
+				Description: "A pipeline-level variable to use for this execution",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Description: "The name of the pipeline variable",
+							Required:    true,
+						},
+						names.AttrValue: schema.StringAttribute{
+							Description: "The value of the pipeline variable",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *startExecutionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startExecutionActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().CodePipelineClient(ctx)
+
+	pipelineName := config.PipelineName.ValueString()
+
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting CodePipeline start execution action", map[string]any{
+		"pipeline_name":   pipelineName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	if _, err := findPipelineByName(ctx, conn, pipelineName); err != nil {
+		if tfresource.NotFound(err) {
+			resp.Diagnostics.AddError("Pipeline Not Found", fmt.Sprintf("CodePipeline pipeline %s was not found", pipelineName))
+			return
+		}
+
+		resp.Diagnostics.AddError("Describing CodePipeline pipeline", err.Error())
+		return
+	}
+
+	input := codepipeline.StartPipelineExecutionInput{
+		Name: aws.String(pipelineName),
+	}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config.SourceRevisions, &input.SourceRevisions)...)
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config.Variables, &input.Variables)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.StartPipelineExecution(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError("Starting CodePipeline pipeline execution", err.Error())
+		return
+	}
+
+	executionID := aws.ToString(output.PipelineExecutionId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Pipeline %s execution %s started", pipelineName, executionID),
+	})
+
+	stageStatuses := make(map[string]awstypes.StageExecutionStatus)
+	actionStatuses := make(map[string]awstypes.ActionExecutionStatus)
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.PipelineExecution], error) {
+		execution, err := findPipelineExecutionByTwoPartKey(ctx, conn, pipelineName, executionID)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.PipelineExecution]{}, err
+		}
+
+		// Stage and action transitions only add detail to progress, so lookup errors are not reported.
+		if state, err := findPipelineStateByName(ctx, conn, pipelineName); err == nil {
+			for _, stage := range state.StageStates {
+				if stage.LatestExecution == nil || aws.ToString(stage.LatestExecution.PipelineExecutionId) != executionID {
+					continue
+				}
+
+				stageName := aws.ToString(stage.StageName)
+				if status := stage.LatestExecution.Status; status != stageStatuses[stageName] {
+					stageStatuses[stageName] = status
+					resp.SendProgress(action.InvokeProgressEvent{
+						Message: fmt.Sprintf("Stage %s is %s", stageName, status),
+					})
+				}
+			}
+		}
+
+		if actions, err := findActionExecutionsByTwoPartKey(ctx, conn, pipelineName, executionID); err == nil {
+			for _, v := range actions {
+				key := actionExecutionKey(v)
+				if v.Status != actionStatuses[key] {
+					actionStatuses[key] = v.Status
+					message := fmt.Sprintf("Action %s is %s", key, v.Status)
+					if url := actionExecutionExternalURL(v); url != "" {
+						message += fmt.Sprintf(" (%s)", url)
+					}
+					resp.SendProgress(action.InvokeProgressEvent{
+						Message: message,
+					})
+				}
+			}
+		}
+
+		return actionwait.FetchResult[*awstypes.PipelineExecution]{Status: actionwait.Status(execution.Status), Value: execution}, nil
+	}, actionwait.Options[*awstypes.PipelineExecution]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startExecutionPollInterval),
+		ProgressInterval: 5 * time.Minute,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.PipelineExecutionStatusSucceeded),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.PipelineExecutionStatusInProgress),
+			actionwait.Status(awstypes.PipelineExecutionStatusStopping),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.PipelineExecutionStatusFailed),
+			actionwait.Status(awstypes.PipelineExecutionStatusStopped),
+			actionwait.Status(awstypes.PipelineExecutionStatusCancelled),
+			actionwait.Status(awstypes.PipelineExecutionStatusSuperseded),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Pipeline %s execution %s is %s (elapsed %s)", pipelineName, executionID, fr.Status, meta.Elapsed.Round(time.Second)),
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError("Pipeline execution timeout", fmt.Sprintf("Pipeline %s execution %s did not finish within %s (last status: %s)", pipelineName, executionID, timeout, timeoutErr.LastStatus))
+		} else if errors.As(err, &failureErr) {
+			// Failed actions only add detail to the failure, so lookup errors are not reported.
+			actions, _ := findActionExecutionsByTwoPartKey(ctx, conn, pipelineName, executionID)
+			resp.Diagnostics.AddError(fmt.Sprintf("Pipeline execution %s", strings.ToLower(string(failureErr.Status))), pipelineExecutionFailureDetail(pipelineName, result.Value, actions))
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected pipeline execution status", err.Error())
+		} else {
+			resp.Diagnostics.AddError("Error waiting for pipeline execution", err.Error())
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Pipeline %s execution %s succeeded", pipelineName, executionID),
+	})
+
+	tflog.Info(ctx, "CodePipeline start execution action completed successfully", map[string]any{
+		"pipeline_name":         pipelineName,
+		"pipeline_execution_id": executionID,
+	})
+}
+
+func findPipelineExecutionByTwoPartKey(ctx context.Context, conn *codepipeline.Client, pipelineName, executionID string) (*awstypes.PipelineExecution, error) {
+	input := codepipeline.GetPipelineExecutionInput{
+		PipelineExecutionId: aws.String(executionID),
+		PipelineName:        aws.String(pipelineName),
+	}
+
+	output, err := conn.GetPipelineExecution(ctx, &input)
+
+	if errs.IsA[*awstypes.PipelineNotFoundException](err) || errs.IsA[*awstypes.PipelineExecutionNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PipelineExecution == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return output.PipelineExecution, nil
+}
+
+func findPipelineStateByName(ctx context.Context, conn *codepipeline.Client, name string) (*codepipeline.GetPipelineStateOutput, error) {
+	input := codepipeline.GetPipelineStateInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetPipelineState(ctx, &input)
+
+	if errs.IsA[*awstypes.PipelineNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return output, nil
+}
+
+func findActionExecutionsByTwoPartKey(ctx context.Context, conn *codepipeline.Client, pipelineName, executionID string) ([]awstypes.ActionExecutionDetail, error) {
+	input := codepipeline.ListActionExecutionsInput{
+		Filter: &awstypes.ActionExecutionFilter{
+			PipelineExecutionId: aws.String(executionID),
+		},
+		PipelineName: aws.String(pipelineName),
+	}
+	var output []awstypes.ActionExecutionDetail
+
+	pages := codepipeline.NewListActionExecutionsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ActionExecutionDetails...)
+	}
+
+	return output, nil
+}
+
+func actionExecutionKey(v awstypes.ActionExecutionDetail) string {
+	return fmt.Sprintf("%s/%s", aws.ToString(v.StageName), aws.ToString(v.ActionName))
+}
+
+func actionExecutionExternalURL(v awstypes.ActionExecutionDetail) string {
+	if v.Output == nil || v.Output.ExecutionResult == nil {
+		return ""
+	}
+
+	return aws.ToString(v.Output.ExecutionResult.ExternalExecutionUrl)
+}
+
+// pipelineExecutionFailureDetail describes why a pipeline execution did not succeed, including the
+// error details and external execution URL of each failed action.
+func pipelineExecutionFailureDetail(pipelineName string, execution *awstypes.PipelineExecution, actions []awstypes.ActionExecutionDetail) string {
+	if execution == nil {
+		return fmt.Sprintf("Pipeline %s execution did not succeed", pipelineName)
+	}
+
+	detail := fmt.Sprintf("Pipeline %s execution %s is %s", pipelineName, aws.ToString(execution.PipelineExecutionId), execution.Status)
+	if v := aws.ToString(execution.StatusSummary); v != "" {
+		detail += ": " + v
+	}
+
+	for _, v := range actions {
+		if v.Status != awstypes.ActionExecutionStatusFailed {
+			continue
+		}
+
+		detail += fmt.Sprintf("\nAction %s failed", actionExecutionKey(v))
+		if v.Output != nil && v.Output.ExecutionResult != nil {
+			if e := v.Output.ExecutionResult.ErrorDetails; e != nil {
+				detail += fmt.Sprintf(": %s: %s", aws.ToString(e.Code), aws.ToString(e.Message))
+			}
+			if v := v.Output.ExecutionResult.ExternalExecutionUrl; v != nil {
+				detail += fmt.Sprintf("\n  External execution: %s", aws.ToString(v))
+			}
+		}
+	}
+
+	return detail
+}
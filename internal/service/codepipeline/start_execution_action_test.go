@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codepipeline_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCodePipelineStartExecutionAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodePipelineServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartExecutionActionConfig_basic(rName, "source.zip"),
+			},
+			{
+				// The deploy action copies the source artifact to a key that includes the pipeline variable.
+				Config: testAccStartExecutionActionConfig_deployedObject(rName, "source.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_s3_object.deployed", names.AttrKey, "deploy/test.zip"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCodePipelineStartExecutionAction_failed(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodePipelineServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartExecutionActionConfig_basic(rName, "missing.zip"),
+				ExpectError: regexache.MustCompile(`(?s)Pipeline execution failed.*Action Source/Source failed`),
+			},
+		},
+	})
+}
+
+func TestAccCodePipelineStartExecutionAction_pipelineNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodePipelineServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartExecutionActionConfig_pipelineNotFound(),
+				ExpectError: regexache.MustCompile(`Pipeline Not Found`),
+			},
+		},
+	})
+}
+
+func testAccStartExecutionActionConfig_base(rName, sourceKey string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_object" "source" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "source.zip"
+  content = %[1]q
+
+  depends_on = [aws_s3_bucket_versioning.test]
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "codepipeline.amazonaws.com"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Statement = [{
+      Action = [
+        "s3:GetBucketVersioning",
+        "s3:GetObject",
+        "s3:GetObjectVersion",
+        "s3:ListBucket",
+        "s3:PutObject",
+        "s3:PutObjectAcl",
+      ]
+      Effect = "Allow"
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_codepipeline" "test" {
+  name          = %[1]q
+  pipeline_type = "V2"
+  role_arn      = aws_iam_role.test.arn
+
+  artifact_store {
+    location = aws_s3_bucket.test.bucket
+    type     = "S3"
+  }
+
+  variable {
+    name          = "Env"
+    default_value = "default"
+  }
+
+  stage {
+    name = "Source"
+
+    action {
+      name             = "Source"
+      category         = "Source"
+      owner            = "AWS"
+      provider         = "S3"
+      version          = "1"
+      output_artifacts = ["source"]
+
+      configuration = {
+        PollForSourceChanges = "false"
+        S3Bucket             = aws_s3_bucket.test.bucket
+        S3ObjectKey          = %[2]q
+      }
+    }
+  }
+
+  stage {
+    name = "Deploy"
+
+    action {
+      name            = "Deploy"
+      category        = "Deploy"
+      owner           = "AWS"
+      provider        = "S3"
+      version         = "1"
+      input_artifacts = ["source"]
+
+      configuration = {
+        BucketName = aws_s3_bucket.test.bucket
+        Extract    = "false"
+        ObjectKey  = "deploy/#{variables.Env}.zip"
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_s3_object.source]
+}
+`, rName, sourceKey)
+}
+
+func testAccStartExecutionActionConfig_basic(rName, sourceKey string) string {
+	return acctest.ConfigCompose(testAccStartExecutionActionConfig_base(rName, sourceKey), `
+action "aws_codepipeline_start_execution" "test" {
+  config {
+    pipeline_name = aws_codepipeline.test.name
+    timeout       = 1200
+
+    variable {
+      name  = "Env"
+      value = "test"
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_codepipeline_start_execution.test]
+    }
+  }
+
+  depends_on = [aws_codepipeline.test]
+}
+`)
+}
+
+func testAccStartExecutionActionConfig_deployedObject(rName, sourceKey string) string {
+	return acctest.ConfigCompose(testAccStartExecutionActionConfig_basic(rName, sourceKey), `
+data "aws_s3_object" "deployed" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "deploy/test.zip"
+}
+`)
+}
+
+func testAccStartExecutionActionConfig_pipelineNotFound() string {
+	return `
+action "aws_codepipeline_start_execution" "test" {
+  config {
+    pipeline_name = "tf-acc-test-not-found"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_codepipeline_start_execution.test]
+    }
+  }
+}
+`
+}
@@ -0,0 +1,305 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datasync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datasync/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// startTaskExecutionPollInterval defines polling cadence for the start task execution action.
+const startTaskExecutionPollInterval = 15 * time.Second
+
+// @Action(aws_datasync_start_task_execution, name="Start Task Execution")
+func newStartTaskExecutionAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startTaskExecutionAction{}, nil
+}
+
+var (
+	_ action.Action = (*startTaskExecutionAction)(nil)
+)
+
+type startTaskExecutionAction struct {
+	framework.ActionWithModel[startTaskExecutionActionModel]
+}
+
+type startTaskExecutionActionModel struct {
+	framework.WithRegionModel
+	Excludes fwtypes.ListNestedObjectValueOf[filterRuleModel] `tfsdk:"excludes"`
+	Includes fwtypes.ListNestedObjectValueOf[filterRuleModel] `tfsdk:"includes"`
+	TaskARN  fwtypes.ARN                                      `tfsdk:"task_arn"`
+	Timeout  types.Int64                                      `tfsdk:"timeout"`
+}
+
+type filterRuleModel struct {
+	FilterType fwtypes.StringEnum[awstypes.FilterType] `tfsdk:"filter_type"`
+	Value      types.String                            `tfsdk:"value"`
+}
+
+func (a *startTaskExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	filterRuleBlock := func(description string) schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType:  fwtypes.NewListNestedObjectTypeOf[filterRuleModel](ctx),
+			Description: description,
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"filter_type": schema.StringAttribute{
+						CustomType:  fwtypes.StringEnumType[awstypes.FilterType](),
+						Description: "The type of filter rule. The only valid value is SIMPLE_PATTERN",
+						Required:    true,
+					},
+					names.AttrValue: schema.StringAttribute{
+						Description: "A single filter string of patterns separated by |, e.g. /folder1|/folder2",
+						Required:    true,
+					},
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Starts an execution of a DataSync task and waits for it to finish. The action fails if the execution fails.",
+		Attributes: map[string]schema.Attribute{
+			"task_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of the DataSync task to start",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the task execution to finish. Defaults to 3600 seconds (60 minutes)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"excludes": filterRuleBlock("A filter rule that excludes data from this execution. Overrides the task's exclude filters"),
+			"includes": filterRuleBlock("A filter rule that limits the data transferred by this execution. Overrides the task's include filters"),
+		},
+	}
+}
+
+func (a *startTaskExecutionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startTaskExecutionActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().DataSyncClient(ctx)
+
+	taskARN := config.TaskARN.ValueString()
+
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting DataSync start task execution action", map[string]any{
+		"task_arn":        taskARN,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	if _, err := findTaskByARN(ctx, conn, taskARN); err != nil {
+		if tfresource.NotFound(err) {
+			resp.Diagnostics.AddError("Task Not Found", fmt.Sprintf("DataSync task %s was not found", taskARN))
+			return
+		}
+
+		resp.Diagnostics.AddError("Describing DataSync task", err.Error())
+		return
+	}
+
+	input := datasync.StartTaskExecutionInput{
+		TaskArn: aws.String(taskARN),
+	}
+
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config.Excludes, &input.Excludes)...)
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config.Includes, &input.Includes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.StartTaskExecution(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError("Starting DataSync task execution", err.Error())
+		return
+	}
+
+	executionARN := aws.ToString(output.TaskExecutionArn)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Task execution %s started, waiting for completion...", executionARN),
+	})
+
+	var lastStatus awstypes.TaskExecutionStatus
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*datasync.DescribeTaskExecutionOutput], error) {
+		execution, err := findTaskExecutionByARN(ctx, conn, executionARN)
+		if err != nil {
+			return actionwait.FetchResult[*datasync.DescribeTaskExecutionOutput]{}, err
+		}
+
+		if execution.Status != lastStatus {
+			lastStatus = execution.Status
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Task execution is %s", execution.Status),
+			})
+		}
+
+		return actionwait.FetchResult[*datasync.DescribeTaskExecutionOutput]{Status: actionwait.Status(execution.Status), Value: execution}, nil
+	}, actionwait.Options[*datasync.DescribeTaskExecutionOutput]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startTaskExecutionPollInterval),
+		ProgressInterval: 2 * time.Minute,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.TaskExecutionStatusSuccess),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.TaskExecutionStatusQueued),
+			actionwait.Status(awstypes.TaskExecutionStatusLaunching),
+			actionwait.Status(awstypes.TaskExecutionStatusPreparing),
+			actionwait.Status(awstypes.TaskExecutionStatusTransferring),
+			actionwait.Status(awstypes.TaskExecutionStatusVerifying),
+			actionwait.Status(awstypes.TaskExecutionStatusCancelling),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.TaskExecutionStatusError),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			message := fmt.Sprintf("Task execution is %s (elapsed %s)", fr.Status, meta.Elapsed.Round(time.Second))
+			if execution, ok := fr.Value.(*datasync.DescribeTaskExecutionOutput); ok && execution != nil {
+				message += fmt.Sprintf(", %s", taskExecutionTransferProgress(execution))
+			}
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: message,
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError("Task execution timeout", fmt.Sprintf("Task execution %s did not finish within %s (last status: %s)", executionARN, timeout, timeoutErr.LastStatus))
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError("Task execution failed", taskExecutionFailureDetail(result.Value))
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected task execution status", err.Error())
+		} else {
+			resp.Diagnostics.AddError("Error waiting for task execution", err.Error())
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Task execution %s succeeded, %s", executionARN, taskExecutionTransferProgress(result.Value)),
+	})
+
+	tflog.Info(ctx, "DataSync start task execution action completed successfully", map[string]any{
+		"task_arn":           taskARN,
+		"task_execution_arn": executionARN,
+	})
+}
+
+func findTaskExecutionByARN(ctx context.Context, conn *datasync.Client, arn string) (*datasync.DescribeTaskExecutionOutput, error) {
+	input := datasync.DescribeTaskExecutionInput{
+		TaskExecutionArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeTaskExecution(ctx, &input)
+
+	if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "not found") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return output, nil
+}
+
+// taskExecutionTransferProgress describes the bytes and files transferred by a task execution.
+// The estimates are only known once the execution has finished preparing.
+func taskExecutionTransferProgress(execution *datasync.DescribeTaskExecutionOutput) string {
+	if execution == nil {
+		return "no transfer statistics reported"
+	}
+
+	progress := fmt.Sprintf("%d", execution.BytesTransferred)
+	if execution.EstimatedBytesToTransfer > 0 {
+		progress += fmt.Sprintf(" of %d", execution.EstimatedBytesToTransfer)
+	}
+	progress += fmt.Sprintf(" bytes and %d", execution.FilesTransferred)
+	if execution.EstimatedFilesToTransfer > 0 {
+		progress += fmt.Sprintf(" of %d", execution.EstimatedFilesToTransfer)
+	}
+	progress += " files transferred"
+
+	if execution.FilesVerified > 0 {
+		progress += fmt.Sprintf(", %d files verified", execution.FilesVerified)
+	}
+
+	return progress
+}
+
+// taskExecutionFailureDetail describes why a task execution failed, including the status of each phase.
+func taskExecutionFailureDetail(execution *datasync.DescribeTaskExecutionOutput) string {
+	if execution == nil {
+		return "Task execution failed"
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Task execution %s is %s", aws.ToString(execution.TaskExecutionArn), execution.Status)
+
+	if v := execution.Result; v != nil {
+		if errorCode, errorDetail := aws.ToString(v.ErrorCode), aws.ToString(v.ErrorDetail); errorCode != "" || errorDetail != "" {
+			fmt.Fprintf(&sb, ": %s: %s", errorCode, errorDetail)
+		}
+		fmt.Fprintf(&sb, "\nPrepare: %s, transfer: %s, verify: %s", v.PrepareStatus, v.TransferStatus, v.VerifyStatus)
+	}
+
+	if v := execution.FilesFailed; v != nil && v.Prepare+v.Transfer+v.Verify+v.Delete > 0 {
+		fmt.Fprintf(&sb, "\nFiles failed to prepare: %d, transfer: %d, verify: %d, delete: %d", v.Prepare, v.Transfer, v.Verify, v.Delete)
+	}
+
+	fmt.Fprintf(&sb, "\nProgress: %s", taskExecutionTransferProgress(execution))
+
+	return sb.String()
+}
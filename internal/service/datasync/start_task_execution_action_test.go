@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datasync_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataSyncStartTaskExecutionAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rName2 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckTaskDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartTaskExecutionActionConfig_basic(rName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStartTaskExecutionObjectCount(ctx, "aws_s3_bucket.test2", "test2/", 2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSyncStartTaskExecutionAction_includes(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rName2 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckTaskDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartTaskExecutionActionConfig_includes(rName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStartTaskExecutionObjectCount(ctx, "aws_s3_bucket.test2", "test2/", 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSyncStartTaskExecutionAction_taskNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartTaskExecutionActionConfig_taskNotFound(),
+				ExpectError: regexache.MustCompile(`Task Not Found`),
+			},
+		},
+	})
+}
+
+// testAccCheckStartTaskExecutionObjectCount checks the number of objects the task execution copied to the destination bucket.
+func testAccCheckStartTaskExecutionObjectCount(ctx context.Context, n, prefix string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		input := s3.ListObjectsV2Input{
+			Bucket: aws.String(rs.Primary.ID),
+			Prefix: aws.String(prefix),
+		}
+
+		output, err := conn.ListObjectsV2(ctx, &input)
+		if err != nil {
+			return err
+		}
+
+		if got := len(output.Contents); got != expected {
+			return fmt.Errorf("expected %d objects under %s in bucket %s, got %d", expected, prefix, rs.Primary.ID, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccStartTaskExecutionActionConfig_base(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccTaskConfig_baseLocationS3(rName),
+		testAccTaskConfig_baseLocationS3_2(rName2),
+		fmt.Sprintf(`
+resource "aws_s3_object" "test" {
+  for_each = toset(["data/a.txt", "logs/b.txt"])
+
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test/${each.key}"
+  content = each.key
+}
+
+resource "aws_datasync_task" "test" {
+  destination_location_arn = aws_datasync_location_s3.test2.arn
+  name                     = %[1]q
+  source_location_arn      = aws_datasync_location_s3.test.arn
+
+  options {
+    gid               = "NONE"
+    posix_permissions = "NONE"
+    uid               = "NONE"
+  }
+}
+`, rName))
+}
+
+func testAccStartTaskExecutionActionConfig_basic(rName, rName2 string) string {
+	return acctest.ConfigCompose(testAccStartTaskExecutionActionConfig_base(rName, rName2), `
+action "aws_datasync_start_task_execution" "test" {
+  config {
+    task_arn = aws_datasync_task.test.arn
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_datasync_start_task_execution.test]
+    }
+  }
+
+  depends_on = [aws_datasync_task.test, aws_s3_object.test]
+}
+`)
+}
+
+func testAccStartTaskExecutionActionConfig_includes(rName, rName2 string) string {
+	return acctest.ConfigCompose(testAccStartTaskExecutionActionConfig_base(rName, rName2), `
+action "aws_datasync_start_task_execution" "test" {
+  config {
+    task_arn = aws_datasync_task.test.arn
+
+    includes {
+      filter_type = "SIMPLE_PATTERN"
+      value       = "/data"
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_datasync_start_task_execution.test]
+    }
+  }
+
+  depends_on = [aws_datasync_task.test, aws_s3_object.test]
+}
+`)
+}
+
+func testAccStartTaskExecutionActionConfig_taskNotFound() string {
+	return `
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+
+action "aws_datasync_start_task_execution" "test" {
+  config {
+    task_arn = "arn:${data.aws_partition.current.partition}:datasync:${data.aws_region.current.region}:${data.aws_caller_identity.current.account_id}:task/task-00000000000000000"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_datasync_start_task_execution.test]
+    }
+  }
+}
+`
+}
@@ -412,6 +412,27 @@ func statusReplicationTask(ctx context.Context, conn *dms.Client, id string) ret
 	}
 }
 
+// replicationTaskProgressFunc is called with the replication task each time it is refreshed while waiting for a status.
+type replicationTaskProgressFunc func(*awstypes.ReplicationTask)
+
+// statusReplicationTaskWithProgress is statusReplicationTask, calling progress (if non-nil) on each refresh.
+func statusReplicationTaskWithProgress(ctx context.Context, conn *dms.Client, id string, progress replicationTaskProgressFunc) retry.StateRefreshFunc {
+	refresh := statusReplicationTask(ctx, conn, id)
+	if progress == nil {
+		return refresh
+	}
+
+	return func() (any, string, error) {
+		outputRaw, status, err := refresh()
+
+		if output, ok := outputRaw.(*awstypes.ReplicationTask); ok && err == nil {
+			progress(output)
+		}
+
+		return outputRaw, status, err
+	}
+}
+
 func setLastReplicationTaskError(err error, replication *awstypes.ReplicationTask) {
 	var errs []error
 
@@ -505,14 +526,11 @@ func waitReplicationTaskReady(ctx context.Context, conn *dms.Client, id string,
 	return nil, err
 }
 
-func waitReplicationTaskRunning(ctx context.Context, conn *dms.Client, id string) (*awstypes.ReplicationTask, error) {
-	const (
-		timeout = 5 * time.Minute
-	)
+func waitReplicationTaskRunning(ctx context.Context, conn *dms.Client, id string, timeout time.Duration, progress replicationTaskProgressFunc) (*awstypes.ReplicationTask, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{replicationTaskStatusStarting},
 		Target:     []string{replicationTaskStatusRunning},
-		Refresh:    statusReplicationTask(ctx, conn, id),
+		Refresh:    statusReplicationTaskWithProgress(ctx, conn, id, progress),
 		Timeout:    timeout,
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second,
@@ -528,14 +546,11 @@ func waitReplicationTaskRunning(ctx context.Context, conn *dms.Client, id string
 	return nil, err
 }
 
-func waitReplicationTaskStopped(ctx context.Context, conn *dms.Client, id string) (*awstypes.ReplicationTask, error) {
-	const (
-		timeout = 5 * time.Minute
-	)
+func waitReplicationTaskStopped(ctx context.Context, conn *dms.Client, id string, timeout time.Duration, progress replicationTaskProgressFunc) (*awstypes.ReplicationTask, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:                   []string{replicationTaskStatusStopping, replicationTaskStatusRunning},
 		Target:                    []string{replicationTaskStatusStopped},
-		Refresh:                   statusReplicationTask(ctx, conn, id),
+		Refresh:                   statusReplicationTaskWithProgress(ctx, conn, id, progress),
 		Timeout:                   timeout,
 		MinTimeout:                10 * time.Second,
 		Delay:                     60 * time.Second,
@@ -577,6 +592,9 @@ func waitReplicationTaskSteady(ctx context.Context, conn *dms.Client, id string)
 }
 
 func startReplicationTask(ctx context.Context, conn *dms.Client, id string) error {
+	const (
+		timeout = 5 * time.Minute
+	)
 	task, err := findReplicationTaskByID(ctx, conn, id)
 
 	if err != nil {
@@ -603,7 +621,7 @@ func startReplicationTask(ctx context.Context, conn *dms.Client, id string) erro
 		return fmt.Errorf("starting DMS Replication Task (%s): %w", id, err)
 	}
 
-	if _, err := waitReplicationTaskRunning(ctx, conn, id); err != nil {
+	if _, err := waitReplicationTaskRunning(ctx, conn, id, timeout, nil); err != nil {
 		return fmt.Errorf("waiting for DMS Replication Task (%s) start: %w", id, err)
 	}
 
@@ -611,6 +629,9 @@ func startReplicationTask(ctx context.Context, conn *dms.Client, id string) erro
 }
 
 func stopReplicationTask(ctx context.Context, conn *dms.Client, id string) error {
+	const (
+		timeout = 5 * time.Minute
+	)
 	task, err := findReplicationTaskByID(ctx, conn, id)
 
 	if tfresource.NotFound(err) {
@@ -640,7 +661,7 @@ func stopReplicationTask(ctx context.Context, conn *dms.Client, id string) error
 		return fmt.Errorf("stopping DMS Replication Task (%s): %w", id, err)
 	}
 
-	if _, err := waitReplicationTaskStopped(ctx, conn, id); err != nil {
+	if _, err := waitReplicationTaskStopped(ctx, conn, id, timeout, nil); err != nil {
 		return fmt.Errorf("waiting for DMS Replication Task (%s) stop: %w", id, err)
 	}
 
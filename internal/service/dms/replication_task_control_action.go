@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	dms "github.com/aws/aws-sdk-go-v2/service/databasemigrationservice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databasemigrationservice/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// replicationTaskOperationStop stops a running replication task. The other operations are the StartReplicationTask types.
+	replicationTaskOperationStop = "stop"
+
+	// replicationTaskStopReasonFullLoadOnlyFinished is reported by full-load-only tasks that stop on their own once the load is done.
+	replicationTaskStopReasonFullLoadOnlyFinished = "FULL_LOAD_ONLY_FINISHED"
+)
+
+// @Action(aws_dms_replication_task_control, name="Replication Task Control")
+func newReplicationTaskControlAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &replicationTaskControlAction{}, nil
+}
+
+var (
+	_ action.Action = (*replicationTaskControlAction)(nil)
+)
+
+type replicationTaskControlAction struct {
+	framework.ActionWithModel[replicationTaskControlActionModel]
+}
+
+type replicationTaskControlActionModel struct {
+	framework.WithRegionModel
+	Operation         types.String `tfsdk:"operation"`
+	ReplicationTaskID types.String `tfsdk:"replication_task_id"`
+	Timeout           types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *replicationTaskControlAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts, resumes, reloads or stops a DMS replication task and waits for it to reach the target status.",
+		Attributes: map[string]schema.Attribute{
+			"operation": schema.StringAttribute{
+				Description: "The operation to perform. Valid values are start-replication, resume-processing, reload-target and stop",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(append(enum.Values[awstypes.StartReplicationTaskTypeValue](), replicationTaskOperationStop)...),
+				},
+			},
+			"replication_task_id": schema.StringAttribute{
+				Description: "The identifier of the replication task",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the replication task to reach the target status. Defaults to 600 seconds (10 minutes)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+	}
+}
+
+func (a *replicationTaskControlAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config replicationTaskControlActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().DMSClient(ctx)
+
+	operation := config.Operation.ValueString()
+	taskID := config.ReplicationTaskID.ValueString()
+
+	timeout := 10 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting DMS replication task control action", map[string]any{
+		"operation":           operation,
+		"replication_task_id": taskID,
+		names.AttrTimeout:     timeout.String(),
+	})
+
+	task, err := findReplicationTaskByID(ctx, conn, taskID)
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.AddError("Replication Task Not Found", fmt.Sprintf("DMS replication task %s was not found", taskID))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Describing DMS replication task", err.Error())
+		return
+	}
+
+	status := aws.ToString(task.Status)
+
+	// Report status and table statistics changes while waiting.
+	lastProgress := fmt.Sprintf("%s, %s", status, replicationTaskStatistics(task))
+	progress := func(task *awstypes.ReplicationTask) {
+		if v := fmt.Sprintf("%s, %s", aws.ToString(task.Status), replicationTaskStatistics(task)); v != lastProgress {
+			lastProgress = v
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Replication task %s is %s", taskID, v),
+			})
+		}
+	}
+
+	if operation == replicationTaskOperationStop {
+		if status == replicationTaskStatusStopped {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Replication task %s is already stopped", taskID),
+			})
+			return
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Stopping replication task %s (status: %s)...", taskID, status),
+		})
+
+		input := dms.StopReplicationTaskInput{
+			ReplicationTaskArn: task.ReplicationTaskArn,
+		}
+
+		if _, err := conn.StopReplicationTask(ctx, &input); err != nil {
+			resp.Diagnostics.AddError("Stopping DMS replication task", err.Error())
+			return
+		}
+
+		task, err = waitReplicationTaskStopped(ctx, conn, taskID, timeout, progress)
+		if err != nil {
+			resp.Diagnostics.AddError("Waiting for DMS replication task to stop", err.Error())
+			return
+		}
+	} else {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Performing %s on replication task %s (status: %s)...", operation, taskID, status),
+		})
+
+		input := dms.StartReplicationTaskInput{
+			ReplicationTaskArn:       task.ReplicationTaskArn,
+			StartReplicationTaskType: awstypes.StartReplicationTaskTypeValue(operation),
+		}
+
+		if _, err := conn.StartReplicationTask(ctx, &input); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Performing %s on DMS replication task", operation), err.Error())
+			return
+		}
+
+		task, err = waitReplicationTaskRunning(ctx, conn, taskID, timeout, progress)
+		if err != nil {
+			// A full-load-only task can finish and stop before the waiter observes it running.
+			if task == nil || !strings.Contains(aws.ToString(task.StopReason), replicationTaskStopReasonFullLoadOnlyFinished) {
+				resp.Diagnostics.AddError("Waiting for DMS replication task to start", err.Error())
+				return
+			}
+		}
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Replication task %s is %s, %s", taskID, aws.ToString(task.Status), replicationTaskStatistics(task)),
+	})
+
+	if v := task.ReplicationTaskStats; v != nil && v.TablesErrored > 0 {
+		resp.Diagnostics.AddWarning("Replication task tables errored", fmt.Sprintf("%d tables of DMS replication task %s errored", v.TablesErrored, taskID))
+	}
+
+	tflog.Info(ctx, "DMS replication task control action completed successfully", map[string]any{
+		"operation":           operation,
+		"replication_task_id": taskID,
+	})
+}
+
+// replicationTaskStatistics describes the full-load progress and table counts of a replication task.
+func replicationTaskStatistics(task *awstypes.ReplicationTask) string {
+	v := task.ReplicationTaskStats
+	if v == nil {
+		return "no table statistics reported"
+	}
+
+	return fmt.Sprintf("full load %d%% complete, tables loaded: %d, loading: %d, queued: %d, errored: %d", v.FullLoadProgressPercent, v.TablesLoaded, v.TablesLoading, v.TablesQueued, v.TablesErrored)
+}
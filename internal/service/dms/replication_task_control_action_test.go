@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databasemigrationservice/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDMSReplicationTaskControlAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_dms_replication_task.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckReplicationTaskDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationTaskControlActionConfig_basic(rName, "start-replication"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationTaskControlStatus(ctx, resourceName, "running"),
+				),
+			},
+			{
+				Config: testAccReplicationTaskControlActionConfig_basic(rName, "stop"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationTaskControlStatus(ctx, resourceName, "stopped"),
+				),
+			},
+			{
+				Config: testAccReplicationTaskControlActionConfig_basic(rName, "resume-processing"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationTaskControlStatus(ctx, resourceName, "running"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDMSReplicationTaskControlAction_taskNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccReplicationTaskControlActionConfig_taskNotFound(rName),
+				ExpectError: regexache.MustCompile(`Replication Task Not Found`),
+			},
+		},
+	})
+}
+
+func testAccCheckReplicationTaskControlStatus(ctx context.Context, n, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var v awstypes.ReplicationTask
+
+		if err := testAccCheckReplicationTaskExists(ctx, n, &v)(s); err != nil {
+			return err
+		}
+
+		if got := aws.ToString(v.Status); got != expected {
+			return fmt.Errorf("DMS Replication Task %s status is %s, expected %s", aws.ToString(v.ReplicationTaskIdentifier), got, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccReplicationTaskControlActionConfig_basic(rName, operation string) string {
+	return acctest.ConfigCompose(testAccReplicationTaskConfig_start(rName, false, "testrule"), fmt.Sprintf(`
+action "aws_dms_replication_task_control" "test" {
+  config {
+    operation           = %[1]q
+    replication_task_id = aws_dms_replication_task.test.replication_task_id
+    timeout             = 900
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = %[1]q
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.aws_dms_replication_task_control.test]
+    }
+  }
+
+  depends_on = [aws_dms_replication_task.test]
+}
+`, operation))
+}
+
+func testAccReplicationTaskControlActionConfig_taskNotFound(rName string) string {
+	return fmt.Sprintf(`
+action "aws_dms_replication_task_control" "test" {
+  config {
+    operation           = "start-replication"
+    replication_task_id = %[1]q
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_dms_replication_task_control.test]
+    }
+  }
+}
+`, rName)
+}
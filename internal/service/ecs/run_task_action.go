@@ -0,0 +1,535 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// runTaskPollInterval defines polling cadence for the run task action.
+const runTaskPollInterval = 10 * time.Second
+
+// Task lifecycle states, in order.
+// See https://docs.aws.amazon.com/AmazonECS/latest/developerguide/task-lifecycle-explanation.html.
+const (
+	taskStatusProvisioning   = "PROVISIONING"
+	taskStatusPending        = "PENDING"
+	taskStatusActivating     = "ACTIVATING"
+	taskStatusRunning        = "RUNNING"
+	taskStatusDeactivating   = "DEACTIVATING"
+	taskStatusStopping       = "STOPPING"
+	taskStatusDeprovisioning = "DEPROVISIONING"
+	taskStatusStopped        = "STOPPED"
+
+	// taskStatusMissing is reported while DescribeTasks doesn't yet return every started task.
+	taskStatusMissing = "MISSING"
+)
+
+var taskStatusOrder = []string{
+	taskStatusProvisioning,
+	taskStatusPending,
+	taskStatusActivating,
+	taskStatusRunning,
+	taskStatusDeactivating,
+	taskStatusStopping,
+	taskStatusDeprovisioning,
+	taskStatusStopped,
+}
+
+// @Action(aws_ecs_run_task, name="Run Task")
+func newRunTaskAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &runTaskAction{}, nil
+}
+
+var (
+	_ action.Action = (*runTaskAction)(nil)
+)
+
+type runTaskAction struct {
+	framework.ActionWithModel[runTaskActionModel]
+}
+
+type runTaskActionModel struct {
+	framework.WithRegionModel
+	CapacityProviderStrategy fwtypes.ListNestedObjectValueOf[capacityProviderStrategyItemModel] `tfsdk:"capacity_provider_strategy"`
+	Cluster                  types.String                                                       `tfsdk:"cluster"`
+	ContainerOverrides       fwtypes.ListNestedObjectValueOf[containerOverrideModel]            `tfsdk:"container_overrides"`
+	LaunchType               fwtypes.StringEnum[awstypes.LaunchType]                            `tfsdk:"launch_type"`
+	NetworkConfiguration     fwtypes.ListNestedObjectValueOf[awsVPCConfigurationModel]          `tfsdk:"network_configuration"`
+	PlatformVersion          types.String                                                       `tfsdk:"platform_version"`
+	StartedBy                types.String                                                       `tfsdk:"started_by"`
+	TaskCount                types.Int64                                                        `tfsdk:"task_count"`
+	TaskDefinition           types.String                                                       `tfsdk:"task_definition"`
+	Timeout                  types.Int64                                                        `tfsdk:"timeout"`
+}
+
+type capacityProviderStrategyItemModel struct {
+	Base             types.Int32  `tfsdk:"base"`
+	CapacityProvider types.String `tfsdk:"capacity_provider"`
+	Weight           types.Int32  `tfsdk:"weight"`
+}
+
+type containerOverrideModel struct {
+	Command     fwtypes.ListOfString                               `tfsdk:"command"`
+	CPU         types.Int32                                        `tfsdk:"cpu"`
+	Environment fwtypes.ListNestedObjectValueOf[keyValuePairModel] `tfsdk:"environment"`
+	Memory      types.Int32                                        `tfsdk:"memory"`
+	Name        types.String                                       `tfsdk:"name"`
+}
+
+type keyValuePairModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+type awsVPCConfigurationModel struct {
+	AssignPublicIP fwtypes.StringEnum[awstypes.AssignPublicIp] `tfsdk:"assign_public_ip"`
+	SecurityGroups fwtypes.SetOfString                         `tfsdk:"security_groups"`
+	Subnets        fwtypes.SetOfString                         `tfsdk:"subnets"`
+}
+
+func (a *runTaskAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a one-off ECS task and waits for it to stop. The action fails if any container exits with a non-zero exit code.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "The short name or ARN of the cluster to run the task on",
+				Required:    true,
+			},
+			"launch_type": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.LaunchType](),
+				Description: "The infrastructure to run the task on. Conflicts with capacity_provider_strategy",
+				Optional:    true,
+			},
+			"platform_version": schema.StringAttribute{
+				Description: "The Fargate platform version to run the task on",
+				Optional:    true,
+			},
+			"started_by": schema.StringAttribute{
+				Description: "An optional tag specified when the task is started, e.g. to identify migration tasks",
+				Optional:    true,
+			},
+			"task_count": schema.Int64Attribute{
+				Description: "The number of instantiations of the task to run. Defaults to 1",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10),
+				},
+			},
+			"task_definition": schema.StringAttribute{
+				Description: "The family and revision (family:revision) or full ARN of the task definition to run",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the task to stop. Defaults to 1800 seconds (30 minutes)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"capacity_provider_strategy": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[capacityProviderStrategyItemModel](ctx),
+				Description: "The capacity provider strategy to use for the task. Conflicts with launch_type",
+				Validators: []validator.List{
+					listvalidator.ConflictsWith(path.MatchRoot("launch_type")),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"base": schema.Int32Attribute{
+							Description: "The minimum number of tasks to run on the capacity provider",
+							Optional:    true,
+						},
+						"capacity_provider": schema.StringAttribute{
+							Description: "The short name of the capacity provider",
+							Required:    true,
+						},
+						names.AttrWeight: schema.Int32Attribute{
+							Description: "The relative percentage of the total number of tasks that should use the capacity provider",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"container_overrides": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[containerOverrideModel](ctx),
+				Description: "Overrides for containers in the task definition",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"command": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Description: "The command to send to the container, overriding the task definition's command",
+							Optional:    true,
+						},
+						"cpu": schema.Int32Attribute{
+							Description: "The number of CPU units reserved for the container",
+							Optional:    true,
+						},
+						"memory": schema.Int32Attribute{
+							Description: "The hard limit (in MiB) of memory to present to the container",
+							Optional:    true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Description: "The name of the container to override",
+							Required:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrEnvironment: schema.ListNestedBlock{
+							CustomType:  fwtypes.NewListNestedObjectTypeOf[keyValuePairModel](ctx),
+							Description: "Environment variables to add to the container, overriding those in the task definition",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrName: schema.StringAttribute{
+										Description: "Environment variable name",
+										Required:    true,
+									},
+									names.AttrValue: schema.StringAttribute{
+										Description: "Environment variable value",
+										Required:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrNetworkConfiguration: schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[awsVPCConfigurationModel](ctx),
+				Description: "The network configuration for tasks using the awsvpc network mode",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"assign_public_ip": schema.StringAttribute{
+							CustomType:  fwtypes.StringEnumType[awstypes.AssignPublicIp](),
+							Description: "Whether the task's elastic network interface receives a public IP address. Defaults to DISABLED",
+							Optional:    true,
+						},
+						names.AttrSecurityGroups: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Description: "The IDs of the security groups associated with the task",
+							Optional:    true,
+						},
+						names.AttrSubnets: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Description: "The IDs of the subnets associated with the task",
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *runTaskAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config runTaskActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().ECSClient(ctx)
+
+	cluster := config.Cluster.ValueString()
+	taskDefinition := config.TaskDefinition.ValueString()
+
+	timeout := 30 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting ECS run task action", map[string]any{
+		"cluster":         cluster,
+		"task_definition": taskDefinition,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Running task %s on ECS cluster %s...", taskDefinition, cluster),
+	})
+
+	input := ecs.RunTaskInput{
+		Cluster:         aws.String(cluster),
+		Count:           aws.Int32(1),
+		LaunchType:      config.LaunchType.ValueEnum(),
+		PlatformVersion: fwflex.StringFromFramework(ctx, config.PlatformVersion),
+		StartedBy:       fwflex.StringFromFramework(ctx, config.StartedBy),
+		TaskDefinition:  aws.String(taskDefinition),
+	}
+
+	if !config.TaskCount.IsNull() {
+		input.Count = aws.Int32(int32(config.TaskCount.ValueInt64()))
+	}
+
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config.CapacityProviderStrategy, &input.CapacityProviderStrategy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ContainerOverrides.IsNull() {
+		input.Overrides = &awstypes.TaskOverride{}
+		resp.Diagnostics.Append(fwflex.Expand(ctx, config.ContainerOverrides, &input.Overrides.ContainerOverrides)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !config.NetworkConfiguration.IsNull() {
+		input.NetworkConfiguration = &awstypes.NetworkConfiguration{}
+		resp.Diagnostics.Append(fwflex.Expand(ctx, config.NetworkConfiguration, &input.NetworkConfiguration.AwsvpcConfiguration)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	output, err := conn.RunTask(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError("Running ECS task", err.Error())
+		return
+	}
+
+	if len(output.Failures) > 0 {
+		resp.Diagnostics.AddError("Running ECS task", errors.Join(tfslices.ApplyToAll(output.Failures, func(v awstypes.Failure) error {
+			return failureError(&v)
+		})...).Error())
+		return
+	}
+
+	taskARNs := make([]string, 0, len(output.Tasks))
+	for _, task := range output.Tasks {
+		taskARNs = append(taskARNs, aws.ToString(task.TaskArn))
+	}
+
+	if len(taskARNs) == 0 {
+		resp.Diagnostics.AddError("Running ECS task", "no tasks were started")
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Started %d task(s), waiting for completion...", len(taskARNs)),
+	})
+
+	// Report each task's lifecycle transitions (PROVISIONING -> RUNNING -> STOPPED) as they are observed.
+	lastStatuses := make(map[string]string, len(taskARNs))
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[[]awstypes.Task], error) {
+		input := ecs.DescribeTasksInput{
+			Cluster: aws.String(cluster),
+			Tasks:   taskARNs,
+		}
+		output, err := conn.DescribeTasks(ctx, &input)
+		if err != nil {
+			return actionwait.FetchResult[[]awstypes.Task]{}, err
+		}
+
+		// DescribeTasks is eventually consistent, so a task that was just started can be reported as MISSING.
+		var errs []error
+		for _, v := range output.Failures {
+			if aws.ToString(v.Reason) != failureReasonMissing {
+				errs = append(errs, failureError(&v))
+			}
+		}
+		if err := errors.Join(errs...); err != nil {
+			return actionwait.FetchResult[[]awstypes.Task]{}, err
+		}
+
+		for _, task := range output.Tasks {
+			taskARN, status := aws.ToString(task.TaskArn), aws.ToString(task.LastStatus)
+			if lastStatuses[taskARN] != status {
+				lastStatuses[taskARN] = status
+				resp.SendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("Task %s is %s", taskIDFromARN(taskARN), status),
+				})
+			}
+		}
+
+		return actionwait.FetchResult[[]awstypes.Task]{Status: actionwait.Status(tasksStatus(output.Tasks, len(taskARNs))), Value: output.Tasks}, nil
+	}, actionwait.Options[[]awstypes.Task]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(runTaskPollInterval),
+		ProgressInterval: 2 * time.Minute,
+		SuccessStates:    []actionwait.Status{taskStatusStopped},
+		TransitionalStates: []actionwait.Status{
+			taskStatusMissing,
+			taskStatusProvisioning,
+			taskStatusPending,
+			taskStatusActivating,
+			taskStatusRunning,
+			taskStatusDeactivating,
+			taskStatusStopping,
+			taskStatusDeprovisioning,
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Tasks currently in state: %s (elapsed %s)", fr.Status, meta.Elapsed.Round(time.Second)),
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError("Task timeout", fmt.Sprintf("ECS task(s) did not stop within %s", timeout))
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected task status", err.Error())
+		} else {
+			resp.Diagnostics.AddError("Error waiting for task", err.Error())
+		}
+		return
+	}
+
+	// Only essential containers are required to exit, a non-essential container can be stopped with its task.
+	essentialContainersByTaskDefinition := make(map[string]map[string]bool)
+
+	var containerFailures []string
+	for _, task := range result.Value {
+		taskID := taskIDFromARN(aws.ToString(task.TaskArn))
+
+		taskDefinitionARN := aws.ToString(task.TaskDefinitionArn)
+		essentialContainers, ok := essentialContainersByTaskDefinition[taskDefinitionARN]
+		if !ok {
+			essentialContainers, err = findEssentialContainerNames(ctx, conn, taskDefinitionARN)
+			if err != nil {
+				// Without the task definition every container is treated as essential.
+				tflog.Warn(ctx, "Reading ECS task definition essential containers", map[string]any{
+					"error": err.Error(),
+				})
+			}
+			essentialContainersByTaskDefinition[taskDefinitionARN] = essentialContainers
+		}
+
+		var taskFailed bool
+		for _, container := range task.Containers {
+			containerName := aws.ToString(container.Name)
+
+			if exitCode := container.ExitCode; exitCode != nil && aws.ToInt32(exitCode) == 0 {
+				continue
+			}
+
+			if container.ExitCode == nil && essentialContainers != nil && !essentialContainers[containerName] {
+				resp.SendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("Task %s non-essential container %s: %s", taskID, containerName, containerExitDetail(container)),
+				})
+				continue
+			}
+
+			taskFailed = true
+			containerFailures = append(containerFailures, fmt.Sprintf("task %s container %s: %s", taskID, containerName, containerExitDetail(container)))
+		}
+
+		if taskFailed && task.StoppedReason != nil {
+			containerFailures = append(containerFailures, fmt.Sprintf("task %s stopped reason: %s", taskID, aws.ToString(task.StoppedReason)))
+		}
+	}
+
+	if len(containerFailures) > 0 {
+		resp.Diagnostics.AddError("Task failed", "One or more containers did not exit successfully:\n"+strings.Join(containerFailures, "\n"))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("%d task(s) completed successfully", len(taskARNs)),
+	})
+
+	tflog.Info(ctx, "ECS run task action completed successfully", map[string]any{
+		"cluster":   cluster,
+		"task_arns": taskARNs,
+	})
+}
+
+// tasksStatus returns the least advanced lifecycle status of the specified tasks,
+// or MISSING if fewer than the expected number of tasks are described.
+func tasksStatus(tasks []awstypes.Task, n int) string {
+	if len(tasks) < n || len(tasks) == 0 {
+		return taskStatusMissing
+	}
+
+	status := taskStatusStopped
+	for _, task := range tasks {
+		if i := slices.Index(taskStatusOrder, aws.ToString(task.LastStatus)); i == -1 {
+			return aws.ToString(task.LastStatus)
+		} else if i < slices.Index(taskStatusOrder, status) {
+			status = taskStatusOrder[i]
+		}
+	}
+
+	return status
+}
+
+// findEssentialContainerNames returns the set of essential container names in the specified task definition.
+func findEssentialContainerNames(ctx context.Context, conn *ecs.Client, taskDefinitionARN string) (map[string]bool, error) {
+	input := ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: aws.String(taskDefinitionARN),
+	}
+	taskDefinition, _, err := findTaskDefinition(ctx, conn, &input)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]bool, len(taskDefinition.ContainerDefinitions))
+	for _, v := range taskDefinition.ContainerDefinitions {
+		// Containers are essential unless explicitly marked otherwise.
+		names[aws.ToString(v.Name)] = aws.ToBool(v.Essential) || v.Essential == nil
+	}
+
+	return names, nil
+}
+
+func taskIDFromARN(arn string) string {
+	if i := strings.LastIndex(arn, "/"); i != -1 {
+		return arn[i+1:]
+	}
+
+	return arn
+}
+
+func containerExitDetail(container awstypes.Container) string {
+	var detail string
+	if container.ExitCode == nil {
+		detail = "no exit code"
+	} else {
+		detail = fmt.Sprintf("exit code %d", aws.ToInt32(container.ExitCode))
+	}
+
+	if reason := aws.ToString(container.Reason); reason != "" {
+		detail += " (" + reason + ")"
+	}
+
+	return detail
+}
@@ -1517,7 +1517,7 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta any
 	d.Set(names.AttrARN, output.Service.ServiceArn)
 
 	if d.Get("wait_for_steady_state").(bool) {
-		if _, err := waitServiceStable(ctx, conn, d.Id(), d.Get("cluster").(string), operationTime, d.Get("sigint_rollback").(bool), d.Timeout(schema.TimeoutCreate)); err != nil {
+		if _, err := waitServiceStable(ctx, conn, d.Id(), d.Get("cluster").(string), operationTime, d.Get("sigint_rollback").(bool), d.Timeout(schema.TimeoutCreate), nil); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) create: %s", d.Id(), err)
 		}
 	} else if _, err := waitServiceActive(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
@@ -1908,7 +1908,7 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta any
 		}
 
 		if d.Get("wait_for_steady_state").(bool) {
-			if _, err := waitServiceStable(ctx, conn, d.Id(), cluster, operationTime, d.Get("sigint_rollback").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			if _, err := waitServiceStable(ctx, conn, d.Id(), cluster, operationTime, d.Get("sigint_rollback").(bool), d.Timeout(schema.TimeoutUpdate), nil); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) update: %s", d.Id(), err)
 			}
 		} else if _, err := waitServiceActive(ctx, conn, d.Id(), cluster, d.Timeout(schema.TimeoutUpdate)); err != nil {
@@ -2427,9 +2427,13 @@ func waitForDeploymentTerminalStatus(ctx context.Context, conn *ecs.Client, prim
 	return err
 }
 
+// serviceStableProgressFunc is called with the service and its status each time the service is refreshed while waiting for it to become stable.
+type serviceStableProgressFunc func(service *awstypes.Service, status string)
+
 // waitServiceStable waits for an ECS Service to reach the status "ACTIVE" and have all desired tasks running.
+// If progress is non-nil, it is called on each refresh.
 // Does not return tags.
-func waitServiceStable(ctx context.Context, conn *ecs.Client, serviceName, clusterNameOrARN string, operationTime time.Time, sigintCancellation bool, timeout time.Duration) (*awstypes.Service, error) { //nolint:unparam
+func waitServiceStable(ctx context.Context, conn *ecs.Client, serviceName, clusterNameOrARN string, operationTime time.Time, sigintCancellation bool, timeout time.Duration, progress serviceStableProgressFunc) (*awstypes.Service, error) { //nolint:unparam
 	sigintConfig := &rollbackState{
 		rollbackConfigured:     sigintCancellation,
 		rollbackRoutineStarted: false,
@@ -2437,10 +2441,24 @@ func waitServiceStable(ctx context.Context, conn *ecs.Client, serviceName, clust
 		waitGroup:              sync.WaitGroup{},
 	}
 
+	refresh := statusServiceWaitForStable(ctx, conn, serviceName, clusterNameOrARN, sigintConfig, operationTime)
+	if progress != nil {
+		statusFunc := refresh
+		refresh = func() (any, string, error) {
+			outputRaw, status, err := statusFunc()
+
+			if output, ok := outputRaw.(*awstypes.Service); ok && err == nil {
+				progress(output, status)
+			}
+
+			return outputRaw, status, err
+		}
+	}
+
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{serviceStatusInactive, serviceStatusDraining, serviceStatusPending},
 		Target:  []string{serviceStatusStable},
-		Refresh: statusServiceWaitForStable(ctx, conn, serviceName, clusterNameOrARN, sigintConfig, operationTime),
+		Refresh: refresh,
 		Timeout: timeout,
 	}
 
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// serviceRedeployMaxEvents is the maximum number of service events reported when a deployment fails.
+const serviceRedeployMaxEvents = 5
+
+// @Action(aws_ecs_service_redeploy, name="Service Redeploy")
+func newServiceRedeployAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &serviceRedeployAction{}, nil
+}
+
+var (
+	_ action.Action = (*serviceRedeployAction)(nil)
+)
+
+type serviceRedeployAction struct {
+	framework.ActionWithModel[serviceRedeployActionModel]
+}
+
+type serviceRedeployActionModel struct {
+	framework.WithRegionModel
+	Cluster types.String `tfsdk:"cluster"`
+	Service types.String `tfsdk:"service"`
+	Timeout types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *serviceRedeployAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Forces a new deployment of an ECS service and waits for the deployment to complete.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "The short name or ARN of the cluster that hosts the service",
+				Required:    true,
+			},
+			"service": schema.StringAttribute{
+				Description: "The name or ARN of the service to redeploy",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the deployment to complete. Defaults to 1200 seconds (20 minutes)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+	}
+}
+
+func (a *serviceRedeployAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config serviceRedeployActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().ECSClient(ctx)
+
+	cluster := config.Cluster.ValueString()
+	serviceName := config.Service.ValueString()
+
+	timeout := 20 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting ECS service redeploy action", map[string]any{
+		"cluster":         cluster,
+		"service":         serviceName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Forcing new deployment of ECS service %s...", serviceName),
+	})
+
+	service, err := findServiceNoTagsByTwoPartKey(ctx, conn, serviceName, cluster)
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.AddError("Service Not Found", fmt.Sprintf("ECS service %s was not found in cluster %s", serviceName, cluster))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Describing ECS service", err.Error())
+		return
+	}
+
+	if status := aws.ToString(service.Status); status != serviceStatusActive {
+		resp.Diagnostics.AddError("Service Not Active", fmt.Sprintf("ECS service %s is %s, expected %s", serviceName, status, serviceStatusActive))
+		return
+	}
+
+	operationTime := time.Now().UTC()
+
+	input := ecs.UpdateServiceInput{
+		Cluster:            aws.String(cluster),
+		ForceNewDeployment: true,
+		Service:            aws.String(serviceName),
+	}
+
+	output, err := conn.UpdateService(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError("Updating ECS service", err.Error())
+		return
+	}
+
+	primaryDeployment := findPrimaryTaskSet(output.Service.Deployments)
+	if primaryDeployment == nil {
+		resp.Diagnostics.AddError("Updating ECS service", "no primary deployment found after forcing new deployment")
+		return
+	}
+	deploymentID := aws.ToString(primaryDeployment.Id)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Deployment %s started, waiting for rollout to complete...", deploymentID),
+	})
+
+	var lastProgress string
+
+	_, err = waitServiceStable(ctx, conn, serviceName, cluster, operationTime, false, timeout, func(service *awstypes.Service, _ string) {
+		progress := fmt.Sprintf("Deployment %s in progress (running %d/%d, pending %d)", deploymentID, service.RunningCount, service.DesiredCount, service.PendingCount)
+		if deployment := findPrimaryTaskSet(service.Deployments); deployment != nil && aws.ToString(deployment.Id) == deploymentID && deployment.RolloutState != "" {
+			progress = fmt.Sprintf("Deployment %s is %s (running %d/%d, pending %d, failed %d)", deploymentID, deployment.RolloutState, deployment.RunningCount, deployment.DesiredCount, deployment.PendingCount, deployment.FailedTasks)
+		}
+
+		if progress != lastProgress {
+			lastProgress = progress
+			resp.SendProgress(action.InvokeProgressEvent{Message: progress})
+		}
+	})
+	if tfresource.TimedOut(err) {
+		resp.Diagnostics.AddError("Deployment timeout", fmt.Sprintf("Deployment %s did not complete within %s", deploymentID, timeout))
+		return
+	}
+	if err != nil {
+		// Re-read the service for the events recorded since the deployment started.
+		service, _ := findServiceNoTagsByTwoPartKey(ctx, conn, serviceName, cluster)
+		resp.Diagnostics.AddError("Deployment failed", serviceDeploymentFailureDetail(service, deploymentID, operationTime, err))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Deployment %s completed successfully", deploymentID),
+	})
+
+	tflog.Info(ctx, "ECS service redeploy action completed successfully", map[string]any{
+		"cluster":       cluster,
+		"service":       serviceName,
+		"deployment_id": deploymentID,
+	})
+}
+
+// serviceDeploymentFailureDetail returns the deployment's failure reason and
+// the service events (e.g. from the deployment circuit breaker) since the deployment started.
+func serviceDeploymentFailureDetail(service *awstypes.Service, deploymentID string, since time.Time, err error) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Deployment %s failed: %s", deploymentID, err)
+	if service == nil {
+		return sb.String()
+	}
+
+	// Service events are returned most recent first.
+	var events []string
+	for _, event := range service.Events {
+		if len(events) == serviceRedeployMaxEvents || aws.ToTime(event.CreatedAt).Before(since) {
+			break
+		}
+		events = append(events, fmt.Sprintf("%s: %s", aws.ToTime(event.CreatedAt).Format(time.RFC3339), aws.ToString(event.Message)))
+	}
+
+	if len(events) > 0 {
+		sb.WriteString("\n\nRecent service events:\n")
+		sb.WriteString(strings.Join(events, "\n"))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,342 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// drainTargetsPollInterval defines polling cadence for the drain targets action.
+const drainTargetsPollInterval = 10 * time.Second
+
+const (
+	drainTargetsModeDeregister = "deregister"
+	drainTargetsModeRegister   = "register"
+)
+
+func drainTargetsModes() []string {
+	return []string{
+		drainTargetsModeDeregister,
+		drainTargetsModeRegister,
+	}
+}
+
+// @Action(aws_elbv2_drain_targets, name="Drain Targets")
+func newDrainTargetsAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &drainTargetsAction{}, nil
+}
+
+var (
+	_ action.Action = (*drainTargetsAction)(nil)
+)
+
+type drainTargetsAction struct {
+	framework.ActionWithModel[drainTargetsActionModel]
+}
+
+type drainTargetsActionModel struct {
+	framework.WithRegionModel
+	Mode           types.String                                 `tfsdk:"mode"`
+	Targets        fwtypes.ListNestedObjectValueOf[targetModel] `tfsdk:"target"`
+	TargetGroupARN fwtypes.ARN                                  `tfsdk:"target_group_arn"`
+	Timeout        types.Int64                                  `tfsdk:"timeout"`
+}
+
+type targetModel struct {
+	AvailabilityZone types.String `tfsdk:"availability_zone"`
+	ID               types.String `tfsdk:"id"`
+	Port             types.Int32  `tfsdk:"port"`
+}
+
+func (a *drainTargetsAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Deregisters targets from, or registers targets with, an ELBv2 target group and waits for connection draining to complete or for the targets to become healthy.",
+		Attributes: map[string]schema.Attribute{
+			"mode": schema.StringAttribute{
+				Description: "Whether to deregister or register the targets. Valid values are deregister and register. Defaults to deregister",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(drainTargetsModes()...),
+				},
+			},
+			"target_group_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of the target group",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the targets to finish draining or to become healthy. Defaults to 900 seconds (15 minutes)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTarget: schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[targetModel](ctx),
+				Description: "A target to deregister or register",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrAvailabilityZone: schema.StringAttribute{
+							Description: "The Availability Zone of the target, or all for an IP address outside the target group's VPC",
+							Optional:    true,
+						},
+						names.AttrID: schema.StringAttribute{
+							Description: "The ID of the target: an instance ID, an IP address, a Lambda function ARN or an Application Load Balancer ARN",
+							Required:    true,
+						},
+						names.AttrPort: schema.Int32Attribute{
+							Description: "The port on which the target receives traffic. Defaults to the target group's port",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *drainTargetsAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config drainTargetsActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().ELBV2Client(ctx)
+
+	targetGroupARN := config.TargetGroupARN.ValueString()
+
+	mode := drainTargetsModeDeregister
+	if !config.Mode.IsNull() {
+		mode = config.Mode.ValueString()
+	}
+
+	timeout := 15 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	var targets []awstypes.TargetDescription
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config.Targets, &targets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Starting ELBv2 drain targets action", map[string]any{
+		"target_group_arn": targetGroupARN,
+		"mode":             mode,
+		"targets":          len(targets),
+		names.AttrTimeout:  timeout.String(),
+	})
+
+	if _, err := findTargetGroupByARN(ctx, conn, targetGroupARN); err != nil {
+		if tfresource.NotFound(err) {
+			resp.Diagnostics.AddError("Target Group Not Found", fmt.Sprintf("ELBv2 target group %s was not found", targetGroupARN))
+			return
+		}
+
+		resp.Diagnostics.AddError("Describing ELBv2 target group", err.Error())
+		return
+	}
+
+	var successState awstypes.TargetHealthStateEnum
+	switch mode {
+	case drainTargetsModeDeregister:
+		input := elasticloadbalancingv2.DeregisterTargetsInput{
+			TargetGroupArn: aws.String(targetGroupARN),
+			Targets:        targets,
+		}
+
+		if _, err := conn.DeregisterTargets(ctx, &input); err != nil {
+			resp.Diagnostics.AddError("Deregistering ELBv2 target group targets", err.Error())
+			return
+		}
+
+		successState = awstypes.TargetHealthStateEnumUnused
+	case drainTargetsModeRegister:
+		input := elasticloadbalancingv2.RegisterTargetsInput{
+			TargetGroupArn: aws.String(targetGroupARN),
+			Targets:        targets,
+		}
+
+		if _, err := conn.RegisterTargets(ctx, &input); err != nil {
+			resp.Diagnostics.AddError("Registering ELBv2 target group targets", err.Error())
+			return
+		}
+
+		successState = awstypes.TargetHealthStateEnumHealthy
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Requested %s of %d target(s) in target group %s, waiting for them to become %s...", mode, len(targets), targetGroupARN, successState),
+	})
+
+	lastStates := make(map[string]awstypes.TargetHealthStateEnum)
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[[]awstypes.TargetHealthDescription], error) {
+		input := elasticloadbalancingv2.DescribeTargetHealthInput{
+			TargetGroupArn: aws.String(targetGroupARN),
+			Targets:        targets,
+		}
+		descriptions, err := findTargetHealthDescriptions(ctx, conn, &input, tfslices.PredicateTrue[*awstypes.TargetHealthDescription]())
+		if err != nil {
+			return actionwait.FetchResult[[]awstypes.TargetHealthDescription]{}, err
+		}
+
+		for _, v := range descriptions {
+			key := targetKey(v.Target)
+			if state := targetHealthState(v); state != lastStates[key] {
+				lastStates[key] = state
+				resp.SendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("Target %s is %s", key, targetHealthSummary(v)),
+				})
+			}
+		}
+
+		return actionwait.FetchResult[[]awstypes.TargetHealthDescription]{Status: drainTargetsStatus(mode, descriptions), Value: descriptions}, nil
+	}, actionwait.Options[[]awstypes.TargetHealthDescription]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(drainTargetsPollInterval),
+		ProgressInterval: 2 * time.Minute,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(successState),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.TargetHealthStateEnumDraining),
+			actionwait.Status(awstypes.TargetHealthStateEnumInitial),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Targets in target group %s are %s (elapsed %s)", targetGroupARN, fr.Status, meta.Elapsed.Round(time.Second)),
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError("Target drain timeout", fmt.Sprintf("Targets in target group %s did not become %s within %s: %s", targetGroupARN, successState, timeout, targetHealthDetail(result.Value)))
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected target health state", err.Error())
+		} else {
+			resp.Diagnostics.AddError("Error waiting for target health", err.Error())
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("%d target(s) in target group %s are %s", len(targets), targetGroupARN, successState),
+	})
+
+	tflog.Info(ctx, "ELBv2 drain targets action completed successfully", map[string]any{
+		"target_group_arn": targetGroupARN,
+		"mode":             mode,
+	})
+}
+
+// targetHealthState returns the state that a target counts as for the drain targets action.
+// Registered targets whose health is not checked, either because health checks are disabled
+// or because the target group is not used by a load balancer, count as healthy.
+func targetHealthState(v awstypes.TargetHealthDescription) awstypes.TargetHealthStateEnum {
+	if v.TargetHealth == nil {
+		return ""
+	}
+
+	switch v.TargetHealth.Reason {
+	case awstypes.TargetHealthReasonEnumHealthCheckDisabled, awstypes.TargetHealthReasonEnumNotInUse:
+		return awstypes.TargetHealthStateEnumHealthy
+	}
+
+	return v.TargetHealth.State
+}
+
+// drainTargetsStatus aggregates the health of all targets into a single status.
+func drainTargetsStatus(mode string, descriptions []awstypes.TargetHealthDescription) actionwait.Status {
+	switch mode {
+	case drainTargetsModeRegister:
+		for _, v := range descriptions {
+			if targetHealthState(v) != awstypes.TargetHealthStateEnumHealthy {
+				return actionwait.Status(awstypes.TargetHealthStateEnumInitial)
+			}
+		}
+
+		return actionwait.Status(awstypes.TargetHealthStateEnumHealthy)
+	default:
+		for _, v := range descriptions {
+			if v.TargetHealth == nil || v.TargetHealth.Reason != awstypes.TargetHealthReasonEnumNotRegistered {
+				return actionwait.Status(awstypes.TargetHealthStateEnumDraining)
+			}
+		}
+
+		return actionwait.Status(awstypes.TargetHealthStateEnumUnused)
+	}
+}
+
+func targetKey(v *awstypes.TargetDescription) string {
+	if v == nil {
+		return ""
+	}
+
+	if v.Port == nil {
+		return aws.ToString(v.Id)
+	}
+
+	return fmt.Sprintf("%s:%d", aws.ToString(v.Id), aws.ToInt32(v.Port))
+}
+
+func targetHealthSummary(v awstypes.TargetHealthDescription) string {
+	if v.TargetHealth == nil {
+		return "unknown"
+	}
+
+	summary := string(v.TargetHealth.State)
+	if v.TargetHealth.Reason != "" {
+		summary += fmt.Sprintf(" (%s", v.TargetHealth.Reason)
+		if description := aws.ToString(v.TargetHealth.Description); description != "" {
+			summary += ": " + description
+		}
+		summary += ")"
+	}
+
+	return summary
+}
+
+func targetHealthDetail(descriptions []awstypes.TargetHealthDescription) string {
+	details := make([]string, 0, len(descriptions))
+	for _, v := range descriptions {
+		details = append(details, fmt.Sprintf("%s is %s", targetKey(v.Target), targetHealthSummary(v)))
+	}
+
+	return strings.Join(details, "; ")
+}
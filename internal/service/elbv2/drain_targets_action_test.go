@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfelbv2 "github.com/hashicorp/terraform-provider-aws/internal/service/elbv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccELBV2DrainTargetsAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_lb_target_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckTargetGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDrainTargetsActionConfig_basic(rName, "register"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDrainTargetsActionTargetRegistered(ctx, resourceName, "10.0.0.10", true),
+				),
+			},
+			{
+				Config: testAccDrainTargetsActionConfig_basic(rName, "deregister"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDrainTargetsActionTargetRegistered(ctx, resourceName, "10.0.0.10", false),
+				),
+			},
+		},
+	})
+}
+
+func TestAccELBV2DrainTargetsAction_targetGroupNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDrainTargetsActionConfig_targetGroupNotFound(),
+				ExpectError: regexache.MustCompile(`Target Group Not Found`),
+			},
+		},
+	})
+}
+
+func testAccCheckDrainTargetsActionTargetRegistered(ctx context.Context, n, targetID string, registered bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBV2Client(ctx)
+
+		input := &elasticloadbalancingv2.DescribeTargetHealthInput{
+			TargetGroupArn: aws.String(rs.Primary.Attributes[names.AttrARN]),
+			Targets: []awstypes.TargetDescription{{
+				Id: aws.String(targetID),
+			}},
+		}
+
+		_, err := tfelbv2.FindTargetHealthDescription(ctx, conn, input)
+
+		if tfresource.NotFound(err) {
+			if registered {
+				return fmt.Errorf("ELBv2 Target Group %s target %s is not registered", rs.Primary.ID, targetID)
+			}
+
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if !registered {
+			return fmt.Errorf("ELBv2 Target Group %s target %s is still registered", rs.Primary.ID, targetID)
+		}
+
+		return nil
+	}
+}
+
+func testAccDrainTargetsActionConfig_basic(rName, mode string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_lb_target_group" "test" {
+  deregistration_delay = 0
+  name                 = %[1]q
+  port                 = 80
+  protocol             = "HTTP"
+  target_type          = "ip"
+  vpc_id               = aws_vpc.test.id
+}
+
+action "aws_elbv2_drain_targets" "test" {
+  config {
+    mode             = %[2]q
+    target_group_arn = aws_lb_target_group.test.arn
+
+    target {
+      id = "10.0.0.10"
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = %[2]q
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.aws_elbv2_drain_targets.test]
+    }
+  }
+
+  depends_on = [aws_lb_target_group.test]
+}
+`, rName, mode)
+}
+
+func testAccDrainTargetsActionConfig_targetGroupNotFound() string {
+	return `
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+
+action "aws_elbv2_drain_targets" "test" {
+  config {
+    target_group_arn = "arn:${data.aws_partition.current.partition}:elasticloadbalancing:${data.aws_region.current.region}:${data.aws_caller_identity.current.account_id}:targetgroup/tf-acc-test-not-found/0123456789abcdef"
+
+    target {
+      id = "10.0.0.10"
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_elbv2_drain_targets.test]
+    }
+  }
+}
+`
+}
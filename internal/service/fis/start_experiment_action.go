@@ -0,0 +1,409 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/fis"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/fis/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// startExperimentPollInterval defines polling cadence for the start experiment action.
+	startExperimentPollInterval = 10 * time.Second
+
+	// stopConditionSourceCloudWatchAlarm is the stop condition source for CloudWatch alarms.
+	stopConditionSourceCloudWatchAlarm = "aws:cloudwatch:alarm"
+)
+
+// @Action(aws_fis_start_experiment, name="Start Experiment")
+func newStartExperimentAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startExperimentAction{}, nil
+}
+
+var (
+	_ action.Action = (*startExperimentAction)(nil)
+)
+
+type startExperimentAction struct {
+	framework.ActionWithModel[startExperimentActionModel]
+}
+
+type startExperimentActionModel struct {
+	framework.WithRegionModel
+	ExperimentTemplateID types.String        `tfsdk:"experiment_template_id"`
+	Tags                 fwtypes.MapOfString `tfsdk:"tags"`
+	Timeout              types.Int64         `tfsdk:"timeout"`
+}
+
+func (a *startExperimentAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an AWS FIS experiment from an experiment template and waits for it to finish. The action fails if the experiment fails or is stopped.",
+		Attributes: map[string]schema.Attribute{
+			"experiment_template_id": schema.StringAttribute{
+				Description: "The ID of the experiment template to start the experiment from",
+				Required:    true,
+			},
+			names.AttrTags: schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Description: "The tags to apply to the experiment",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the experiment to finish. Defaults to 3600 seconds (60 minutes)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+	}
+}
+
+func (a *startExperimentAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startExperimentActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().FISClient(ctx)
+
+	templateID := config.ExperimentTemplateID.ValueString()
+
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting FIS start experiment action", map[string]any{
+		"experiment_template_id": templateID,
+		names.AttrTimeout:        timeout.String(),
+	})
+
+	if _, err := findExperimentTemplateByID(ctx, conn, templateID); err != nil {
+		if tfresource.NotFound(err) {
+			resp.Diagnostics.AddError("Experiment Template Not Found", fmt.Sprintf("FIS experiment template %s was not found", templateID))
+			return
+		}
+
+		resp.Diagnostics.AddError("Describing FIS experiment template", err.Error())
+		return
+	}
+
+	input := fis.StartExperimentInput{
+		ExperimentTemplateId: aws.String(templateID),
+		Tags:                 fwflex.ExpandFrameworkStringValueMap(ctx, config.Tags),
+	}
+
+	output, err := conn.StartExperiment(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError("Starting FIS experiment", err.Error())
+		return
+	}
+
+	experimentID := aws.ToString(output.Experiment.Id)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Experiment %s started from template %s, waiting for completion...", experimentID, templateID),
+	})
+
+	var lastStatus awstypes.ExperimentStatus
+	lastActionStatuses := make(map[string]awstypes.ExperimentActionStatus)
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Experiment], error) {
+		experiment, err := findExperimentByID(ctx, conn, experimentID)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Experiment]{}, err
+		}
+
+		status := experiment.State.Status
+		if status != lastStatus {
+			lastStatus = status
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Experiment %s is %s", experimentID, status),
+			})
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(experiment.Actions)) {
+			v := experiment.Actions[name]
+			if v.State == nil || v.State.Status == lastActionStatuses[name] {
+				continue
+			}
+
+			lastActionStatuses[name] = v.State.Status
+			message := fmt.Sprintf("Experiment action %s (%s) is %s", name, aws.ToString(v.ActionId), v.State.Status)
+			if reason := aws.ToString(v.State.Reason); reason != "" {
+				message += fmt.Sprintf(": %s", reason)
+			}
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: message,
+			})
+		}
+
+		return actionwait.FetchResult[*awstypes.Experiment]{Status: actionwait.Status(status), Value: experiment}, nil
+	}, actionwait.Options[*awstypes.Experiment]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startExperimentPollInterval),
+		ProgressInterval: 2 * time.Minute,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.ExperimentStatusCompleted),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.ExperimentStatusPending),
+			actionwait.Status(awstypes.ExperimentStatusInitiating),
+			actionwait.Status(awstypes.ExperimentStatusRunning),
+			actionwait.Status(awstypes.ExperimentStatusStopping),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.ExperimentStatusStopped),
+			actionwait.Status(awstypes.ExperimentStatusFailed),
+			actionwait.Status(awstypes.ExperimentStatusCancelled),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Experiment %s is %s (elapsed %s)", experimentID, fr.Status, meta.Elapsed.Round(time.Second)),
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError("Experiment timeout", fmt.Sprintf("Experiment %s did not finish within %s (last status: %s)", experimentID, timeout, timeoutErr.LastStatus))
+		} else if errors.As(err, &failureErr) {
+			alarms := a.stopConditionAlarmsInAlarm(ctx, result.Value)
+			resp.Diagnostics.AddError(fmt.Sprintf("Experiment %s", failureErr.Status), experimentFailureDetail(result.Value, alarms))
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected experiment status", err.Error())
+		} else {
+			resp.Diagnostics.AddError("Error waiting for experiment", err.Error())
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Experiment %s completed", experimentID),
+	})
+
+	tflog.Info(ctx, "FIS start experiment action completed successfully", map[string]any{
+		"experiment_id":          experimentID,
+		"experiment_template_id": templateID,
+	})
+}
+
+// stopConditionAlarmsInAlarm returns the ARNs of the experiment's stop condition alarms that were in the ALARM state while the experiment ran.
+// Alarms are looked up on a best-effort basis, as they only add detail to the failure.
+func (a *startExperimentAction) stopConditionAlarmsInAlarm(ctx context.Context, experiment *awstypes.Experiment) []string {
+	if experiment == nil {
+		return nil
+	}
+
+	alarmARNs := make(map[string]string)
+	for _, v := range experiment.StopConditions {
+		if aws.ToString(v.Source) != stopConditionSourceCloudWatchAlarm {
+			continue
+		}
+
+		alarmARN := aws.ToString(v.Value)
+		parsed, err := arn.Parse(alarmARN)
+		if err != nil {
+			continue
+		}
+		alarmARNs[strings.TrimPrefix(parsed.Resource, "alarm:")] = alarmARN
+	}
+
+	if len(alarmARNs) == 0 {
+		return nil
+	}
+
+	conn := a.Meta().CloudWatchClient(ctx)
+
+	// An alarm's current state doesn't reflect the experiment if it has since changed.
+	startTime, endTime := aws.ToTime(experiment.StartTime), time.Now()
+	if v := experiment.EndTime; v != nil {
+		endTime = aws.ToTime(v)
+	}
+
+	input := cloudwatch.DescribeAlarmsInput{
+		AlarmNames: slices.Collect(maps.Keys(alarmARNs)),
+		AlarmTypes: []cloudwatchtypes.AlarmType{cloudwatchtypes.AlarmTypeCompositeAlarm, cloudwatchtypes.AlarmTypeMetricAlarm},
+	}
+
+	output, err := conn.DescribeAlarms(ctx, &input)
+	if err != nil {
+		tflog.Warn(ctx, "Describing FIS experiment stop condition alarms", map[string]any{
+			"error": err.Error(),
+		})
+		return nil
+	}
+
+	type alarmState struct {
+		arn, name             string
+		stateUpdatedTimestamp time.Time
+		stateValue            cloudwatchtypes.StateValue
+	}
+	var states []alarmState
+	for _, v := range output.MetricAlarms {
+		states = append(states, alarmState{aws.ToString(v.AlarmArn), aws.ToString(v.AlarmName), aws.ToTime(v.StateUpdatedTimestamp), v.StateValue})
+	}
+	for _, v := range output.CompositeAlarms {
+		states = append(states, alarmState{aws.ToString(v.AlarmArn), aws.ToString(v.AlarmName), aws.ToTime(v.StateUpdatedTimestamp), v.StateValue})
+	}
+
+	var alarms []string
+	for _, v := range states {
+		inAlarm := v.stateValue == cloudwatchtypes.StateValueAlarm && !v.stateUpdatedTimestamp.After(endTime)
+
+		// If the state changed during or after the experiment, the alarm's history shows whether it was in ALARM during the experiment.
+		if !inAlarm && v.stateUpdatedTimestamp.After(startTime) {
+			inAlarm, err = alarmWasInAlarm(ctx, conn, v.name, startTime, endTime)
+			if err != nil {
+				tflog.Warn(ctx, "Describing FIS experiment stop condition alarm history", map[string]any{
+					"error": err.Error(),
+				})
+				continue
+			}
+		}
+
+		if inAlarm {
+			alarms = append(alarms, v.arn)
+		}
+	}
+	slices.Sort(alarms)
+
+	return alarms
+}
+
+// alarmWasInAlarm returns whether the specified alarm transitioned to, or was in, the ALARM state between startTime and endTime.
+func alarmWasInAlarm(ctx context.Context, conn *cloudwatch.Client, name string, startTime, endTime time.Time) (bool, error) {
+	input := cloudwatch.DescribeAlarmHistoryInput{
+		AlarmName:       aws.String(name),
+		AlarmTypes:      []cloudwatchtypes.AlarmType{cloudwatchtypes.AlarmTypeCompositeAlarm, cloudwatchtypes.AlarmTypeMetricAlarm},
+		EndDate:         aws.Time(endTime),
+		HistoryItemType: cloudwatchtypes.HistoryItemTypeStateUpdate,
+		StartDate:       aws.Time(startTime),
+	}
+
+	pages := cloudwatch.NewDescribeAlarmHistoryPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return false, err
+		}
+
+		for _, v := range page.AlarmHistoryItems {
+			var data struct {
+				NewState struct {
+					StateValue cloudwatchtypes.StateValue `json:"stateValue"`
+				} `json:"newState"`
+				OldState struct {
+					StateValue cloudwatchtypes.StateValue `json:"stateValue"`
+				} `json:"oldState"`
+			}
+			if err := json.Unmarshal([]byte(aws.ToString(v.HistoryData)), &data); err != nil {
+				continue
+			}
+
+			if data.NewState.StateValue == cloudwatchtypes.StateValueAlarm || data.OldState.StateValue == cloudwatchtypes.StateValueAlarm {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func findExperimentByID(ctx context.Context, conn *fis.Client, id string) (*awstypes.Experiment, error) {
+	input := fis.GetExperimentInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetExperiment(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Experiment == nil || output.Experiment.State == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return output.Experiment, nil
+}
+
+// experimentFailureDetail describes why an experiment did not complete, including the state of each of its actions
+// and any stop condition alarms that halted it.
+func experimentFailureDetail(experiment *awstypes.Experiment, alarms []string) string {
+	if experiment == nil {
+		return "Experiment did not complete"
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Experiment %s is %s", aws.ToString(experiment.Id), experiment.State.Status)
+	if reason := aws.ToString(experiment.State.Reason); reason != "" {
+		fmt.Fprintf(&sb, ": %s", reason)
+	}
+	if v := experiment.State.Error; v != nil {
+		fmt.Fprintf(&sb, "\nError %s", aws.ToString(v.Code))
+		if location := aws.ToString(v.Location); location != "" {
+			fmt.Fprintf(&sb, " at %s", location)
+		}
+	}
+
+	if len(alarms) > 0 {
+		fmt.Fprintf(&sb, "\nStop condition alarms in ALARM state: %s", strings.Join(alarms, ", "))
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(experiment.Actions)) {
+		v := experiment.Actions[name]
+		if v.State == nil {
+			continue
+		}
+
+		fmt.Fprintf(&sb, "\n  action %s (%s) is %s", name, aws.ToString(v.ActionId), v.State.Status)
+		if reason := aws.ToString(v.State.Reason); reason != "" {
+			fmt.Fprintf(&sb, ": %s", reason)
+		}
+	}
+
+	return sb.String()
+}
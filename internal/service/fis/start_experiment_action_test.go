@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fis_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccFISStartExperimentAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FISServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckExperimentTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartExperimentActionConfig_basic(rName),
+			},
+		},
+	})
+}
+
+func TestAccFISStartExperimentAction_stopCondition(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FISServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckExperimentTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartExperimentActionConfig_stopCondition(rName),
+				ExpectError: regexache.MustCompile(`(?s)Experiment (stopped|failed).*Stop condition alarms in ALARM state: arn:[^:]+:cloudwatch:`),
+			},
+		},
+	})
+}
+
+func TestAccFISStartExperimentAction_templateNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FISServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartExperimentActionConfig_templateNotFound(),
+				ExpectError: regexache.MustCompile(`Experiment Template Not Found`),
+			},
+		},
+	})
+}
+
+func testAccStartExperimentActionConfig_base(rName, stopCondition string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = [
+          "fis.${data.aws_partition.current.dns_suffix}",
+        ]
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_fis_experiment_template" "test" {
+  description = %[1]q
+  role_arn    = aws_iam_role.test.arn
+
+  stop_condition {
+%[2]s
+  }
+
+  action {
+    name      = "wait"
+    action_id = "aws:fis:wait"
+
+    parameter {
+      key   = "duration"
+      value = "PT1M"
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, stopCondition)
+}
+
+func testAccStartExperimentActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStartExperimentActionConfig_base(rName, `    source = "none"`), fmt.Sprintf(`
+action "aws_fis_start_experiment" "test" {
+  config {
+    experiment_template_id = aws_fis_experiment_template.test.id
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_fis_start_experiment.test]
+    }
+  }
+
+  depends_on = [aws_fis_experiment_template.test]
+}
+`, rName))
+}
+
+func testAccStartExperimentActionConfig_stopCondition(rName string) string {
+	return acctest.ConfigCompose(testAccStartExperimentActionConfig_base(rName, `    source = "aws:cloudwatch:alarm"
+    value  = aws_cloudwatch_metric_alarm.test.arn`), fmt.Sprintf(`
+# An alarm on a metric that is never published goes to ALARM once it is evaluated.
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanThreshold"
+  evaluation_periods  = 1
+  metric_name         = %[1]q
+  namespace           = "tf-acc-test"
+  period              = 60
+  statistic           = "Sum"
+  threshold           = 0
+  treat_missing_data  = "breaching"
+}
+
+action "aws_fis_start_experiment" "test" {
+  config {
+    experiment_template_id = aws_fis_experiment_template.test.id
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_fis_start_experiment.test]
+    }
+  }
+
+  depends_on = [aws_fis_experiment_template.test]
+}
+`, rName))
+}
+
+func testAccStartExperimentActionConfig_templateNotFound() string {
+	return `
+action "aws_fis_start_experiment" "test" {
+  config {
+    experiment_template_id = "EXT00000000000000"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_fis_start_experiment.test]
+    }
+  }
+}
+`
+}
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// startCrawlerPollInterval defines polling cadence for the start crawler action.
+const startCrawlerPollInterval = 15 * time.Second
+
+// @Action(aws_glue_start_crawler, name="Start Crawler")
+func newStartCrawlerAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startCrawlerAction{}, nil
+}
+
+var (
+	_ action.Action = (*startCrawlerAction)(nil)
+)
+
+type startCrawlerAction struct {
+	framework.ActionWithModel[startCrawlerActionModel]
+}
+
+type startCrawlerActionModel struct {
+	framework.WithRegionModel
+	CrawlerName types.String `tfsdk:"crawler_name"`
+	Timeout     types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *startCrawlerAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts a Glue crawler and waits for the crawl to finish. The action fails if the crawl fails or is cancelled.",
+		Attributes: map[string]schema.Attribute{
+			"crawler_name": schema.StringAttribute{
+				Description: "The name of the Glue crawler to start",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the crawl to finish. Defaults to 3600 seconds (60 minutes)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+	}
+}
+
+func (a *startCrawlerAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startCrawlerActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().GlueClient(ctx)
+
+	crawlerName := config.CrawlerName.ValueString()
+
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting Glue start crawler action", map[string]any{
+		"crawler_name":    crawlerName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	crawler, err := findCrawlerByName(ctx, conn, crawlerName)
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.AddError("Crawler Not Found", fmt.Sprintf("Glue crawler %s was not found", crawlerName))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Describing Glue crawler", err.Error())
+		return
+	}
+
+	// The crawler returns to READY once the crawl finishes; a new LastCrawl start time identifies this crawl's result.
+	var previousCrawlStart time.Time
+	if v := crawler.LastCrawl; v != nil {
+		previousCrawlStart = aws.ToTime(v.StartTime)
+	}
+
+	input := glue.StartCrawlerInput{
+		Name: aws.String(crawlerName),
+	}
+
+	if _, err := conn.StartCrawler(ctx, &input); err != nil {
+		if errs.IsA[*awstypes.CrawlerRunningException](err) {
+			resp.Diagnostics.AddError("Crawler Already Running", fmt.Sprintf("Glue crawler %s is already running", crawlerName))
+			return
+		}
+
+		resp.Diagnostics.AddError("Starting Glue crawler", err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Crawler %s started, waiting for completion...", crawlerName),
+	})
+
+	var lastState awstypes.CrawlerState
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Crawler], error) {
+		crawler, err := findCrawlerByName(ctx, conn, crawlerName)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Crawler]{}, err
+		}
+
+		if crawler.State != lastState {
+			lastState = crawler.State
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Crawler %s is %s", crawlerName, crawler.State),
+			})
+		}
+
+		status := actionwait.Status(crawler.State)
+		if v := crawler.LastCrawl; crawler.State == awstypes.CrawlerStateReady && v != nil && !aws.ToTime(v.StartTime).Equal(previousCrawlStart) {
+			status = actionwait.Status(v.Status)
+		}
+
+		return actionwait.FetchResult[*awstypes.Crawler]{Status: status, Value: crawler}, nil
+	}, actionwait.Options[*awstypes.Crawler]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startCrawlerPollInterval),
+		ProgressInterval: 2 * time.Minute,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.LastCrawlStatusSucceeded),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.CrawlerStateReady),
+			actionwait.Status(awstypes.CrawlerStateRunning),
+			actionwait.Status(awstypes.CrawlerStateStopping),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.LastCrawlStatusFailed),
+			actionwait.Status(awstypes.LastCrawlStatusCancelled),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Crawler %s is %s (elapsed %s)", crawlerName, fr.Status, meta.Elapsed.Round(time.Second)),
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError("Crawler timeout", fmt.Sprintf("Crawler %s did not finish within %s (last status: %s)", crawlerName, timeout, timeoutErr.LastStatus))
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError("Crawl failed", crawlFailureDetail(result.Value))
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected crawler status", err.Error())
+		} else {
+			resp.Diagnostics.AddError("Error waiting for crawler", err.Error())
+		}
+		return
+	}
+
+	message := fmt.Sprintf("Crawler %s succeeded", crawlerName)
+	if metrics, err := findCrawlerMetricsByName(ctx, conn, crawlerName); err == nil {
+		message += fmt.Sprintf(" in %.0fs: %d tables created, %d updated, %d deleted", metrics.LastRuntimeSeconds, metrics.TablesCreated, metrics.TablesUpdated, metrics.TablesDeleted)
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: message,
+	})
+
+	tflog.Info(ctx, "Glue start crawler action completed successfully", map[string]any{
+		"crawler_name": crawlerName,
+	})
+}
+
+func findCrawlerMetricsByName(ctx context.Context, conn *glue.Client, name string) (*awstypes.CrawlerMetrics, error) {
+	input := glue.GetCrawlerMetricsInput{
+		CrawlerNameList: []string{name},
+	}
+
+	output, err := conn.GetCrawlerMetrics(ctx, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.CrawlerMetricsList)
+}
+
+// crawlFailureDetail describes why a crawler's last crawl failed.
+func crawlFailureDetail(crawler *awstypes.Crawler) string {
+	if crawler == nil || crawler.LastCrawl == nil {
+		return "Crawl failed"
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Crawl of %s is %s", aws.ToString(crawler.Name), crawler.LastCrawl.Status)
+	if message := aws.ToString(crawler.LastCrawl.ErrorMessage); message != "" {
+		fmt.Fprintf(&sb, ": %s", message)
+	}
+	if logGroup, logStream := aws.ToString(crawler.LastCrawl.LogGroup), aws.ToString(crawler.LastCrawl.LogStream); logGroup != "" {
+		fmt.Fprintf(&sb, "\nLogs: %s/%s", logGroup, logStream)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// startJobRunPollInterval defines polling cadence for the start job run action.
+const startJobRunPollInterval = 15 * time.Second
+
+// @Action(aws_glue_start_job_run, name="Start Job Run")
+func newStartJobRunAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startJobRunAction{}, nil
+}
+
+var (
+	_ action.Action = (*startJobRunAction)(nil)
+)
+
+type startJobRunAction struct {
+	framework.ActionWithModel[startJobRunActionModel]
+}
+
+type startJobRunActionModel struct {
+	framework.WithRegionModel
+	Arguments       fwtypes.MapOfString                     `tfsdk:"arguments"`
+	JobName         types.String                            `tfsdk:"job_name"`
+	NumberOfWorkers types.Int64                             `tfsdk:"number_of_workers"`
+	Timeout         types.Int64                             `tfsdk:"timeout"`
+	WorkerType      fwtypes.StringEnum[awstypes.WorkerType] `tfsdk:"worker_type"`
+}
+
+func (a *startJobRunAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts a run of a Glue job and waits for it to finish. The action fails if the job run fails, times out or is stopped.",
+		Attributes: map[string]schema.Attribute{
+			"arguments": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Description: "The job arguments for this run, e.g. --day=2024-01-01. These replace the default arguments set in the job definition",
+				Optional:    true,
+			},
+			"job_name": schema.StringAttribute{
+				Description: "The name of the Glue job to run",
+				Required:    true,
+			},
+			"number_of_workers": schema.Int64Attribute{
+				Description: "The number of workers allocated to this run. Overrides the job definition",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the job run to finish. Defaults to 3600 seconds (60 minutes)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+			"worker_type": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.WorkerType](),
+				Description: "The type of worker allocated to this run. Overrides the job definition",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *startJobRunAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startJobRunActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().GlueClient(ctx)
+
+	jobName := config.JobName.ValueString()
+
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting Glue start job run action", map[string]any{
+		"job_name":        jobName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	if _, err := findJobByName(ctx, conn, jobName); err != nil {
+		if tfresource.NotFound(err) {
+			resp.Diagnostics.AddError("Job Not Found", fmt.Sprintf("Glue job %s was not found", jobName))
+			return
+		}
+
+		resp.Diagnostics.AddError("Describing Glue job", err.Error())
+		return
+	}
+
+	input := glue.StartJobRunInput{
+		Arguments:       fwflex.ExpandFrameworkStringValueMap(ctx, config.Arguments),
+		JobName:         aws.String(jobName),
+		NumberOfWorkers: fwflex.Int32FromFrameworkInt64(ctx, config.NumberOfWorkers),
+		WorkerType:      config.WorkerType.ValueEnum(),
+	}
+
+	output, err := conn.StartJobRun(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError("Starting Glue job run", err.Error())
+		return
+	}
+
+	runID := aws.ToString(output.JobRunId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Job run %s of %s started, waiting for completion...", runID, jobName),
+	})
+
+	var lastState awstypes.JobRunState
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.JobRun], error) {
+		jobRun, err := findJobRunByTwoPartKey(ctx, conn, jobName, runID)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.JobRun]{}, err
+		}
+
+		if jobRun.JobRunState != lastState {
+			lastState = jobRun.JobRunState
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Job run %s is %s", runID, jobRun.JobRunState),
+			})
+		}
+
+		return actionwait.FetchResult[*awstypes.JobRun]{Status: actionwait.Status(jobRun.JobRunState), Value: jobRun}, nil
+	}, actionwait.Options[*awstypes.JobRun]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startJobRunPollInterval),
+		ProgressInterval: 2 * time.Minute,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.JobRunStateSucceeded),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.JobRunStateStarting),
+			actionwait.Status(awstypes.JobRunStateRunning),
+			actionwait.Status(awstypes.JobRunStateStopping),
+			actionwait.Status(awstypes.JobRunStateWaiting),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.JobRunStateFailed),
+			actionwait.Status(awstypes.JobRunStateTimeout),
+			actionwait.Status(awstypes.JobRunStateError),
+			actionwait.Status(awstypes.JobRunStateStopped),
+			actionwait.Status(awstypes.JobRunStateExpired),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Job run %s is %s (elapsed %s)", runID, fr.Status, meta.Elapsed.Round(time.Second)),
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError("Job run timeout", fmt.Sprintf("Job run %s of %s did not finish within %s (last status: %s)", runID, jobName, timeout, timeoutErr.LastStatus))
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError("Job run failed", jobRunFailureDetail(result.Value))
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected job run status", err.Error())
+		} else {
+			resp.Diagnostics.AddError("Error waiting for job run", err.Error())
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Job run %s of %s succeeded, %s", runID, jobName, jobRunUsage(result.Value)),
+	})
+
+	tflog.Info(ctx, "Glue start job run action completed successfully", map[string]any{
+		"job_name":   jobName,
+		"job_run_id": runID,
+	})
+}
+
+func findJobRunByTwoPartKey(ctx context.Context, conn *glue.Client, jobName, runID string) (*awstypes.JobRun, error) {
+	input := glue.GetJobRunInput{
+		JobName: aws.String(jobName),
+		RunId:   aws.String(runID),
+	}
+
+	output, err := conn.GetJobRun(ctx, &input)
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.JobRun == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return output.JobRun, nil
+}
+
+// workerTypeDPUs is the number of DPUs allocated to each worker of a worker type.
+var workerTypeDPUs = map[awstypes.WorkerType]float64{
+	awstypes.WorkerTypeStandard: 1,
+	awstypes.WorkerTypeG025x:    0.25,
+	awstypes.WorkerTypeG1x:      1,
+	awstypes.WorkerTypeG2x:      2,
+	awstypes.WorkerTypeG4x:      4,
+	awstypes.WorkerTypeG8x:      8,
+	awstypes.WorkerTypeZ2x:      2,
+}
+
+// jobRunUsage describes the execution time and DPU seconds consumed by a job run.
+// Only jobs with auto scaling report DPU seconds, for other jobs they are estimated from the execution time and allocated capacity.
+func jobRunUsage(jobRun *awstypes.JobRun) string {
+	if jobRun == nil {
+		return "no usage reported"
+	}
+
+	usage := fmt.Sprintf("execution time %ds", jobRun.ExecutionTime)
+	if v := jobRun.DPUSeconds; v != nil {
+		return usage + fmt.Sprintf(", %.0f DPU seconds", aws.ToFloat64(v))
+	}
+
+	var dpus float64
+	if v := jobRun.MaxCapacity; v != nil {
+		dpus = aws.ToFloat64(v)
+	} else if v, ok := workerTypeDPUs[jobRun.WorkerType]; ok {
+		dpus = float64(aws.ToInt32(jobRun.NumberOfWorkers)) * v
+	}
+
+	if dpus == 0 {
+		return usage + ", DPU usage unavailable"
+	}
+
+	return usage + fmt.Sprintf(", approximately %.0f DPU seconds (%g DPUs allocated)", float64(jobRun.ExecutionTime)*dpus, dpus)
+}
+
+// jobRunFailureDetail describes why a job run failed.
+func jobRunFailureDetail(jobRun *awstypes.JobRun) string {
+	if jobRun == nil {
+		return "Job run failed"
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Job run %s of %s is %s", aws.ToString(jobRun.Id), aws.ToString(jobRun.JobName), jobRun.JobRunState)
+	if message := aws.ToString(jobRun.ErrorMessage); message != "" {
+		fmt.Fprintf(&sb, ": %s", message)
+	}
+	fmt.Fprintf(&sb, "\nUsage: %s", jobRunUsage(jobRun))
+
+	return sb.String()
+}
@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package imagebuilder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// startPipelineExecutionPollInterval defines polling cadence for the start pipeline execution action.
+const startPipelineExecutionPollInterval = 30 * time.Second
+
+// @Action(aws_imagebuilder_start_pipeline_execution, name="Start Pipeline Execution")
+func newStartPipelineExecutionAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startPipelineExecutionAction{}, nil
+}
+
+var (
+	_ action.Action = (*startPipelineExecutionAction)(nil)
+)
+
+type startPipelineExecutionAction struct {
+	framework.ActionWithModel[startPipelineExecutionActionModel]
+}
+
+type startPipelineExecutionActionModel struct {
+	framework.WithRegionModel
+	ImagePipelineARN fwtypes.ARN         `tfsdk:"image_pipeline_arn"`
+	Tags             fwtypes.MapOfString `tfsdk:"tags"`
+	Timeout          types.Int64         `tfsdk:"timeout"`
+}
+
+func (a *startPipelineExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an Image Builder pipeline execution and waits for the resulting image to become available. The action fails if the image build fails or is cancelled.",
+		Attributes: map[string]schema.Attribute{
+			"image_pipeline_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of the image pipeline to start",
+				Required:    true,
+			},
+			names.AttrTags: schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Description: "The tags to apply to the image created by this execution",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the image to become available. Defaults to 7200 seconds (2 hours)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+	}
+}
+
+func (a *startPipelineExecutionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startPipelineExecutionActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().ImageBuilderClient(ctx)
+
+	pipelineARN := config.ImagePipelineARN.ValueString()
+
+	timeout := 120 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting Image Builder start pipeline execution action", map[string]any{
+		"image_pipeline_arn": pipelineARN,
+		names.AttrTimeout:    timeout.String(),
+	})
+
+	if _, err := findImagePipelineByARN(ctx, conn, pipelineARN); err != nil {
+		if tfresource.NotFound(err) {
+			resp.Diagnostics.AddError("Image Pipeline Not Found", fmt.Sprintf("Image Builder image pipeline %s was not found", pipelineARN))
+			return
+		}
+
+		resp.Diagnostics.AddError("Describing Image Builder image pipeline", err.Error())
+		return
+	}
+
+	input := imagebuilder.StartImagePipelineExecutionInput{
+		ImagePipelineArn: aws.String(pipelineARN),
+		Tags:             fwflex.ExpandFrameworkStringValueMap(ctx, config.Tags),
+	}
+
+	output, err := conn.StartImagePipelineExecution(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError("Starting Image Builder image pipeline execution", err.Error())
+		return
+	}
+
+	imageARN := aws.ToString(output.ImageBuildVersionArn)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Pipeline execution started, building image %s...", imageARN),
+	})
+
+	var lastStatus awstypes.ImageStatus
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Image], error) {
+		image, err := findImageByARN(ctx, conn, imageARN)
+		if tfresource.NotFound(err) {
+			// The image may not be visible immediately after the execution starts.
+			return actionwait.FetchResult[*awstypes.Image]{Status: actionwait.Status(awstypes.ImageStatusPending)}, nil
+		}
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Image]{}, err
+		}
+
+		if image.State.Status != lastStatus {
+			lastStatus = image.State.Status
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Image %s is %s", imageARN, image.State.Status),
+			})
+		}
+
+		return actionwait.FetchResult[*awstypes.Image]{Status: actionwait.Status(image.State.Status), Value: image}, nil
+	}, actionwait.Options[*awstypes.Image]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startPipelineExecutionPollInterval),
+		ProgressInterval: 5 * time.Minute,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.ImageStatusAvailable),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.ImageStatusPending),
+			actionwait.Status(awstypes.ImageStatusCreating),
+			actionwait.Status(awstypes.ImageStatusBuilding),
+			actionwait.Status(awstypes.ImageStatusTesting),
+			actionwait.Status(awstypes.ImageStatusDistributing),
+			actionwait.Status(awstypes.ImageStatusIntegrating),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.ImageStatusFailed),
+			actionwait.Status(awstypes.ImageStatusCancelled),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Image %s is %s (elapsed %s)", imageARN, fr.Status, meta.Elapsed.Round(time.Second)),
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError("Image build timeout", fmt.Sprintf("Image %s did not become available within %s (last status: %s)", imageARN, timeout, timeoutErr.LastStatus))
+		} else if errors.As(err, &failureErr) {
+			// Failed workflow steps only add detail to the failure, so lookup errors are not reported.
+			steps, _ := findFailedWorkflowStepsByImageARN(ctx, conn, imageARN)
+			resp.Diagnostics.AddError("Image build failed", imageFailureDetail(imageARN, result.Value, steps))
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected image status", err.Error())
+		} else {
+			resp.Diagnostics.AddError("Error waiting for image", err.Error())
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Image %s is available: %s", imageARN, imageOutputResources(result.Value)),
+	})
+
+	tflog.Info(ctx, "Image Builder start pipeline execution action completed successfully", map[string]any{
+		"image_arn":          imageARN,
+		"image_pipeline_arn": pipelineARN,
+	})
+}
+
+func findWorkflowExecutionsByImageARN(ctx context.Context, conn *imagebuilder.Client, arn string) ([]awstypes.WorkflowExecutionMetadata, error) {
+	input := imagebuilder.ListWorkflowExecutionsInput{
+		ImageBuildVersionArn: aws.String(arn),
+	}
+	var output []awstypes.WorkflowExecutionMetadata
+
+	pages := imagebuilder.NewListWorkflowExecutionsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.WorkflowExecutions...)
+	}
+
+	return output, nil
+}
+
+func findWorkflowStepExecutionsByID(ctx context.Context, conn *imagebuilder.Client, id string) ([]awstypes.WorkflowStepMetadata, error) {
+	input := imagebuilder.ListWorkflowStepExecutionsInput{
+		WorkflowExecutionId: aws.String(id),
+	}
+	var output []awstypes.WorkflowStepMetadata
+
+	pages := imagebuilder.NewListWorkflowStepExecutionsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Steps...)
+	}
+
+	return output, nil
+}
+
+// findFailedWorkflowStepsByImageARN returns the failed steps of the workflows that built an image.
+func findFailedWorkflowStepsByImageARN(ctx context.Context, conn *imagebuilder.Client, arn string) ([]awstypes.WorkflowStepMetadata, error) {
+	executions, err := findWorkflowExecutionsByImageARN(ctx, conn, arn)
+	if err != nil {
+		return nil, err
+	}
+
+	var output []awstypes.WorkflowStepMetadata
+	for _, execution := range executions {
+		if execution.Status != awstypes.WorkflowExecutionStatusFailed {
+			continue
+		}
+
+		steps, err := findWorkflowStepExecutionsByID(ctx, conn, aws.ToString(execution.WorkflowExecutionId))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, step := range steps {
+			if step.Status == awstypes.WorkflowStepExecutionStatusFailed {
+				output = append(output, step)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+// imageOutputResources describes the AMIs and container images distributed by an image build, per region.
+func imageOutputResources(image *awstypes.Image) string {
+	if image == nil || image.OutputResources == nil {
+		return "no output resources reported"
+	}
+
+	var resources []string
+	for _, v := range image.OutputResources.Amis {
+		resources = append(resources, fmt.Sprintf("%s: %s", aws.ToString(v.Region), aws.ToString(v.Image)))
+	}
+	for _, v := range image.OutputResources.Containers {
+		resources = append(resources, fmt.Sprintf("%s: %s", aws.ToString(v.Region), strings.Join(v.ImageUris, ", ")))
+	}
+
+	if len(resources) == 0 {
+		return "no output resources reported"
+	}
+
+	return strings.Join(resources, "; ")
+}
+
+// imageFailureDetail describes why an image build failed, including the failed workflow steps.
+func imageFailureDetail(arn string, image *awstypes.Image, steps []awstypes.WorkflowStepMetadata) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Image %s", arn)
+	if image != nil {
+		fmt.Fprintf(&sb, " is %s", image.State.Status)
+		if reason := aws.ToString(image.State.Reason); reason != "" {
+			fmt.Fprintf(&sb, ": %s", reason)
+		}
+	} else {
+		sb.WriteString(" failed")
+	}
+
+	for _, step := range steps {
+		fmt.Fprintf(&sb, "\n  step %s (%s) failed", aws.ToString(step.Name), aws.ToString(step.Action))
+		if message := aws.ToString(step.Message); message != "" {
+			fmt.Fprintf(&sb, ": %s", message)
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package imagebuilder_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccImageBuilderStartPipelineExecutionAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ImageBuilderServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckImagePipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartPipelineExecutionActionConfig_basic(rName),
+			},
+		},
+	})
+}
+
+func TestAccImageBuilderStartPipelineExecutionAction_failed(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ImageBuilderServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckImagePipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartPipelineExecutionActionConfig_failed(rName),
+				ExpectError: regexache.MustCompile(`(?s)Image build failed.*is FAILED`),
+			},
+		},
+	})
+}
+
+func TestAccImageBuilderStartPipelineExecutionAction_pipelineNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ImageBuilderServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartPipelineExecutionActionConfig_pipelineNotFound(),
+				ExpectError: regexache.MustCompile(`Image Pipeline Not Found`),
+			},
+		},
+	})
+}
+
+func testAccStartPipelineExecutionActionConfig_trigger() string {
+	return `
+action "aws_imagebuilder_start_pipeline_execution" "test" {
+  config {
+    image_pipeline_arn = aws_imagebuilder_image_pipeline.test.arn
+    timeout            = 5400
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_imagebuilder_start_pipeline_execution.test]
+    }
+  }
+
+  depends_on = [aws_imagebuilder_image_pipeline.test]
+}
+`
+}
+
+func testAccStartPipelineExecutionActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccImageBaseConfig(rName), fmt.Sprintf(`
+resource "aws_imagebuilder_image_pipeline" "test" {
+  image_recipe_arn                 = aws_imagebuilder_image_recipe.test.arn
+  infrastructure_configuration_arn = aws_imagebuilder_infrastructure_configuration.test.arn
+  name                             = %[1]q
+}
+`, rName), testAccStartPipelineExecutionActionConfig_trigger())
+}
+
+func testAccStartPipelineExecutionActionConfig_failed(rName string) string {
+	return acctest.ConfigCompose(testAccImageBaseConfig(rName), fmt.Sprintf(`
+resource "aws_imagebuilder_component" "fail" {
+  data = yamlencode({
+    phases = [{
+      name = "build"
+      steps = [{
+        action = "ExecuteBash"
+        inputs = {
+          commands = ["exit 1"]
+        }
+        name      = "fail"
+        onFailure = "Abort"
+      }]
+    }]
+    schemaVersion = 1.0
+  })
+  name     = "%[1]s-fail"
+  platform = "Linux"
+  version  = "1.0.0"
+}
+
+resource "aws_imagebuilder_image_recipe" "fail" {
+  component {
+    component_arn = aws_imagebuilder_component.fail.arn
+  }
+
+  name         = "%[1]s-fail"
+  parent_image = "arn:${data.aws_partition.current.partition}:imagebuilder:${data.aws_region.current.region}:aws:image/amazon-linux-2-x86/x.x.x"
+  version      = "1.0.0"
+}
+
+resource "aws_imagebuilder_image_pipeline" "test" {
+  image_recipe_arn                 = aws_imagebuilder_image_recipe.fail.arn
+  infrastructure_configuration_arn = aws_imagebuilder_infrastructure_configuration.test.arn
+  name                             = %[1]q
+}
+`, rName), testAccStartPipelineExecutionActionConfig_trigger())
+}
+
+func testAccStartPipelineExecutionActionConfig_pipelineNotFound() string {
+	return `
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+
+action "aws_imagebuilder_start_pipeline_execution" "test" {
+  config {
+    image_pipeline_arn = "arn:${data.aws_partition.current.partition}:imagebuilder:${data.aws_region.current.region}:${data.aws_caller_identity.current.account_id}:image-pipeline/tf-acc-test-not-found"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_imagebuilder_start_pipeline_execution.test]
+    }
+  }
+}
+`
+}
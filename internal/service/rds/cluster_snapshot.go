@@ -262,11 +262,11 @@ func resourceClusterSnapshotDelete(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
-func findDBClusterSnapshotByID(ctx context.Context, conn *rds.Client, id string) (*types.DBClusterSnapshot, error) {
+func findDBClusterSnapshotByID(ctx context.Context, conn *rds.Client, id string, optFns ...func(*rds.Options)) (*types.DBClusterSnapshot, error) {
 	input := &rds.DescribeDBClusterSnapshotsInput{
 		DBClusterSnapshotIdentifier: aws.String(id),
 	}
-	output, err := findDBClusterSnapshot(ctx, conn, input, tfslices.PredicateTrue[*types.DBClusterSnapshot]())
+	output, err := findDBClusterSnapshot(ctx, conn, input, tfslices.PredicateTrue[*types.DBClusterSnapshot](), optFns...)
 
 	if err != nil {
 		return nil, err
@@ -282,8 +282,8 @@ func findDBClusterSnapshotByID(ctx context.Context, conn *rds.Client, id string)
 	return output, nil
 }
 
-func findDBClusterSnapshot(ctx context.Context, conn *rds.Client, input *rds.DescribeDBClusterSnapshotsInput, filter tfslices.Predicate[*types.DBClusterSnapshot]) (*types.DBClusterSnapshot, error) {
-	output, err := findDBClusterSnapshots(ctx, conn, input, filter)
+func findDBClusterSnapshot(ctx context.Context, conn *rds.Client, input *rds.DescribeDBClusterSnapshotsInput, filter tfslices.Predicate[*types.DBClusterSnapshot], optFns ...func(*rds.Options)) (*types.DBClusterSnapshot, error) {
+	output, err := findDBClusterSnapshots(ctx, conn, input, filter, optFns...)
 
 	if err != nil {
 		return nil, err
@@ -292,12 +292,12 @@ func findDBClusterSnapshot(ctx context.Context, conn *rds.Client, input *rds.Des
 	return tfresource.AssertSingleValueResult(output)
 }
 
-func findDBClusterSnapshots(ctx context.Context, conn *rds.Client, input *rds.DescribeDBClusterSnapshotsInput, filter tfslices.Predicate[*types.DBClusterSnapshot]) ([]types.DBClusterSnapshot, error) {
+func findDBClusterSnapshots(ctx context.Context, conn *rds.Client, input *rds.DescribeDBClusterSnapshotsInput, filter tfslices.Predicate[*types.DBClusterSnapshot], optFns ...func(*rds.Options)) ([]types.DBClusterSnapshot, error) {
 	var output []types.DBClusterSnapshot
 
 	pages := rds.NewDescribeDBClusterSnapshotsPaginator(conn, input)
 	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
+		page, err := pages.NextPage(ctx, optFns...)
 
 		if errs.IsA[*types.DBClusterSnapshotNotFoundFault](err) {
 			return nil, &retry.NotFoundError{
@@ -320,9 +320,9 @@ func findDBClusterSnapshots(ctx context.Context, conn *rds.Client, input *rds.De
 	return output, nil
 }
 
-func statusDBClusterSnapshot(ctx context.Context, conn *rds.Client, id string) retry.StateRefreshFunc {
+func statusDBClusterSnapshot(ctx context.Context, conn *rds.Client, id string, optFns ...func(*rds.Options)) retry.StateRefreshFunc {
 	return func() (any, string, error) {
-		output, err := findDBClusterSnapshotByID(ctx, conn, id)
+		output, err := findDBClusterSnapshotByID(ctx, conn, id, optFns...)
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
@@ -336,11 +336,11 @@ func statusDBClusterSnapshot(ctx context.Context, conn *rds.Client, id string) r
 	}
 }
 
-func waitDBClusterSnapshotCreated(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.DBClusterSnapshot, error) { //nolint:unparam
+func waitDBClusterSnapshotCreated(ctx context.Context, conn *rds.Client, id string, timeout time.Duration, optFns ...func(*rds.Options)) (*types.DBClusterSnapshot, error) { //nolint:unparam
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{clusterSnapshotStatusCreating, clusterSnapshotStatusCopying},
 		Target:     []string{clusterSnapshotStatusAvailable},
-		Refresh:    statusDBClusterSnapshot(ctx, conn, id),
+		Refresh:    statusDBClusterSnapshot(ctx, conn, id, optFns...),
 		Timeout:    timeout,
 		MinTimeout: 10 * time.Second,
 		Delay:      5 * time.Second,
@@ -16,22 +16,24 @@ const (
 )
 
 const (
-	clusterStatusAvailable                     = "available"
-	clusterStatusBackingUp                     = "backing-up"
-	clusterStatusConfiguringEnhancedMonitoring = "configuring-enhanced-monitoring"
-	clusterStatusConfiguringIAMDatabaseAuth    = "configuring-iam-database-auth"
-	clusterStatusCreating                      = "creating"
-	clusterStatusDeleting                      = "deleting"
-	clusterStatusMigrating                     = "migrating"
-	clusterStatusModifying                     = "modifying"
-	clusterStatusPreparingDataMigration        = "preparing-data-migration"
-	clusterStatusPromoting                     = "promoting"
-	clusterStatusRebooting                     = "rebooting"
-	clusterStatusRenaming                      = "renaming"
-	clusterStatusResettingMasterCredentials    = "resetting-master-credentials"
-	clusterStatusScalingCompute                = "scaling-compute"
-	clusterStatusScalingStorage                = "scaling-storage"
-	clusterStatusUpgrading                     = "upgrading"
+	clusterStatusAvailable                                    = "available"
+	clusterStatusBackingUp                                    = "backing-up"
+	clusterStatusConfiguringEnhancedMonitoring                = "configuring-enhanced-monitoring"
+	clusterStatusConfiguringIAMDatabaseAuth                   = "configuring-iam-database-auth"
+	clusterStatusCreating                                     = "creating"
+	clusterStatusDeleting                                     = "deleting"
+	clusterStatusInaccessibleEncryptionCredentials            = "inaccessible-encryption-credentials"
+	clusterStatusInaccessibleEncryptionCredentialsRecoverable = "inaccessible-encryption-credentials-recoverable"
+	clusterStatusMigrating                                    = "migrating"
+	clusterStatusModifying                                    = "modifying"
+	clusterStatusPreparingDataMigration                       = "preparing-data-migration"
+	clusterStatusPromoting                                    = "promoting"
+	clusterStatusRebooting                                    = "rebooting"
+	clusterStatusRenaming                                     = "renaming"
+	clusterStatusResettingMasterCredentials                   = "resetting-master-credentials"
+	clusterStatusScalingCompute                               = "scaling-compute"
+	clusterStatusScalingStorage                               = "scaling-storage"
+	clusterStatusUpgrading                                    = "upgrading"
 
 	// Non-standard status values.
 	clusterStatusAvailableWithPendingModifiedValues = "tf-available-with-pending-modified-values"
@@ -0,0 +1,560 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// failoverPollInterval defines polling cadence for the failover action.
+const failoverPollInterval = 15 * time.Second
+
+const (
+	failoverOperationFailover   = "failover"
+	failoverOperationSwitchover = "switchover"
+)
+
+const (
+	// failoverStatusCompleted is a synthetic status reported once the new writer is available.
+	failoverStatusCompleted = "tf-failover-completed"
+	// failoverStatusReverted is a synthetic status reported once a global cluster's failover state
+	// is cleared without the target DB cluster becoming the writer.
+	failoverStatusReverted = "tf-failover-reverted"
+)
+
+// @Action(aws_rds_failover, name="Failover")
+func newFailoverAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &failoverAction{}, nil
+}
+
+var (
+	_ action.Action = (*failoverAction)(nil)
+)
+
+type failoverAction struct {
+	framework.ActionWithModel[failoverActionModel]
+}
+
+type failoverActionModel struct {
+	framework.WithRegionModel
+	DBClusterIdentifier        types.String `tfsdk:"db_cluster_identifier"`
+	DBInstanceIdentifier       types.String `tfsdk:"db_instance_identifier"`
+	GlobalClusterIdentifier    types.String `tfsdk:"global_cluster_identifier"`
+	Operation                  types.String `tfsdk:"operation"`
+	TargetDBClusterIdentifier  types.String `tfsdk:"target_db_cluster_identifier"`
+	TargetDBInstanceIdentifier types.String `tfsdk:"target_db_instance_identifier"`
+	Timeout                    types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *failoverAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fails over an RDS DB cluster, global cluster or Multi-AZ DB instance and waits for the new writer to be available.",
+		Attributes: map[string]schema.Attribute{
+			"db_cluster_identifier": schema.StringAttribute{
+				Description: "The identifier of the DB cluster to fail over. Exactly one of db_cluster_identifier, db_instance_identifier or global_cluster_identifier must be specified",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("db_instance_identifier"),
+						path.MatchRoot("global_cluster_identifier"),
+					),
+				},
+			},
+			"db_instance_identifier": schema.StringAttribute{
+				Description: "The identifier of the Multi-AZ DB instance to fail over by rebooting it with failover",
+				Optional:    true,
+			},
+			"global_cluster_identifier": schema.StringAttribute{
+				Description: "The identifier of the global cluster to fail over or switch over",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("target_db_cluster_identifier")),
+				},
+			},
+			"operation": schema.StringAttribute{
+				Description: "The global cluster operation to perform. Valid values are switchover (no data loss, the default) and failover (allows data loss, for recovering from a regional outage)",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(failoverOperationFailover, failoverOperationSwitchover),
+					stringvalidator.AlsoRequires(path.MatchRoot("global_cluster_identifier")),
+				},
+			},
+			"target_db_cluster_identifier": schema.StringAttribute{
+				Description: "The ARN of the secondary DB cluster to promote to writer of the global cluster",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("global_cluster_identifier")),
+				},
+			},
+			"target_db_instance_identifier": schema.StringAttribute{
+				Description: "The identifier of the DB instance to promote to writer of the DB cluster. Defaults to a reader chosen by RDS",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("db_cluster_identifier")),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the new writer to be available. Defaults to 1800 seconds (30 minutes)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+	}
+}
+
+func (a *failoverAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config failoverActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := 30 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	switch {
+	case !config.DBClusterIdentifier.IsNull():
+		a.failoverDBCluster(ctx, config, timeout, resp)
+	case !config.GlobalClusterIdentifier.IsNull():
+		a.failoverGlobalCluster(ctx, config, timeout, resp)
+	default:
+		a.failoverDBInstance(ctx, config, timeout, resp)
+	}
+}
+
+func (a *failoverAction) failoverDBCluster(ctx context.Context, config failoverActionModel, timeout time.Duration, resp *action.InvokeResponse) {
+	conn := a.Meta().RDSClient(ctx)
+	clusterID := config.DBClusterIdentifier.ValueString()
+
+	tflog.Info(ctx, "Starting RDS DB cluster failover action", map[string]any{
+		"db_cluster_identifier": clusterID,
+		names.AttrTimeout:       timeout.String(),
+	})
+
+	cluster, err := findDBClusterByID(ctx, conn, clusterID)
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.AddError("DB Cluster Not Found", fmt.Sprintf("RDS DB cluster %s was not found", clusterID))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Describing RDS DB cluster", err.Error())
+		return
+	}
+
+	if status := aws.ToString(cluster.Status); status != clusterStatusAvailable {
+		resp.Diagnostics.AddError("DB Cluster Not Available", fmt.Sprintf("RDS DB cluster %s is %s, expected %s", clusterID, status, clusterStatusAvailable))
+		return
+	}
+
+	oldWriter := dbClusterWriterInstanceID(cluster)
+
+	readers := dbClusterReaderInstanceIDs(cluster)
+	if len(readers) == 0 {
+		resp.Diagnostics.AddError("No Failover Target", fmt.Sprintf("RDS DB cluster %s has no reader instances to fail over to", clusterID))
+		return
+	}
+
+	if !config.TargetDBInstanceIdentifier.IsNull() {
+		targetID := config.TargetDBInstanceIdentifier.ValueString()
+		if targetID == oldWriter {
+			resp.Diagnostics.AddError("Invalid Target", fmt.Sprintf("DB instance %s is already the writer of RDS DB cluster %s", targetID, clusterID))
+			return
+		}
+		if !slices.Contains(readers, targetID) {
+			resp.Diagnostics.AddError("Invalid Target", fmt.Sprintf("DB instance %s is not a reader of RDS DB cluster %s, readers are: %s", targetID, clusterID, strings.Join(readers, ", ")))
+			return
+		}
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Failing over DB cluster %s, current writer is %s", clusterID, dbInstanceEndpointDescription(ctx, conn, oldWriter)),
+	})
+
+	input := rds.FailoverDBClusterInput{
+		DBClusterIdentifier: aws.String(clusterID),
+	}
+	if !config.TargetDBInstanceIdentifier.IsNull() {
+		input.TargetDBInstanceIdentifier = config.TargetDBInstanceIdentifier.ValueStringPointer()
+	}
+
+	if _, err := conn.FailoverDBCluster(ctx, &input); err != nil {
+		resp.Diagnostics.AddError("Failing over RDS DB cluster", err.Error())
+		return
+	}
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[string], error) {
+		cluster, err := findDBClusterByID(ctx, conn, clusterID)
+		if err != nil {
+			return actionwait.FetchResult[string]{}, err
+		}
+
+		writer, status := dbClusterWriterInstanceID(cluster), aws.ToString(cluster.Status)
+		if status == clusterStatusAvailable && writer != "" && writer != oldWriter {
+			instance, err := findDBInstanceByID(ctx, conn, writer)
+			if err != nil {
+				return actionwait.FetchResult[string]{}, err
+			}
+
+			switch aws.ToString(instance.DBInstanceStatus) {
+			case instanceStatusAvailable:
+				status = failoverStatusCompleted
+			case instanceStatusFailed:
+				status = instanceStatusFailed
+			}
+		}
+
+		return actionwait.FetchResult[string]{Status: actionwait.Status(status), Value: writer}, nil
+	}, failoverWaitOptions[string](timeout, []actionwait.Status{
+		clusterStatusInaccessibleEncryptionCredentials,
+		clusterStatusInaccessibleEncryptionCredentialsRecoverable,
+		instanceStatusFailed,
+	}, resp))
+	if err != nil {
+		addFailoverWaitError(err, fmt.Sprintf("DB cluster %s", clusterID), "", timeout, resp)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("DB cluster %s failover completed, writer changed from %s to %s", clusterID, dbInstanceEndpointDescription(ctx, conn, oldWriter), dbInstanceEndpointDescription(ctx, conn, result.Value)),
+	})
+
+	tflog.Info(ctx, "RDS DB cluster failover action completed successfully", map[string]any{
+		"db_cluster_identifier": clusterID,
+		"old_writer":            oldWriter,
+		"new_writer":            result.Value,
+	})
+}
+
+func (a *failoverAction) failoverGlobalCluster(ctx context.Context, config failoverActionModel, timeout time.Duration, resp *action.InvokeResponse) {
+	conn := a.Meta().RDSClient(ctx)
+	globalClusterID := config.GlobalClusterIdentifier.ValueString()
+	targetARN := config.TargetDBClusterIdentifier.ValueString()
+
+	operation := failoverOperationSwitchover
+	if !config.Operation.IsNull() {
+		operation = config.Operation.ValueString()
+	}
+
+	tflog.Info(ctx, "Starting RDS global cluster failover action", map[string]any{
+		"global_cluster_identifier":    globalClusterID,
+		"operation":                    operation,
+		"target_db_cluster_identifier": targetARN,
+		names.AttrTimeout:              timeout.String(),
+	})
+
+	globalCluster, err := findGlobalClusterByID(ctx, conn, globalClusterID)
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.AddError("Global Cluster Not Found", fmt.Sprintf("RDS global cluster %s was not found", globalClusterID))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Describing RDS global cluster", err.Error())
+		return
+	}
+
+	// A global failover is used when the primary region is unavailable, so its status isn't checked.
+	if status := aws.ToString(globalCluster.Status); operation == failoverOperationSwitchover && status != globalClusterStatusAvailable {
+		resp.Diagnostics.AddError("Global Cluster Not Available", fmt.Sprintf("RDS global cluster %s is %s, expected %s", globalClusterID, status, globalClusterStatusAvailable))
+		return
+	}
+
+	oldWriter := globalClusterWriterARN(globalCluster)
+	if oldWriter == targetARN {
+		resp.Diagnostics.AddError("Invalid Target", fmt.Sprintf("DB cluster %s is already the writer of RDS global cluster %s", targetARN, globalClusterID))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting global cluster %s %s, current writer is %s", globalClusterID, operation, a.dbClusterEndpointDescription(ctx, oldWriter)),
+	})
+
+	if operation == failoverOperationFailover {
+		input := rds.FailoverGlobalClusterInput{
+			AllowDataLoss:             aws.Bool(true),
+			GlobalClusterIdentifier:   aws.String(globalClusterID),
+			TargetDbClusterIdentifier: aws.String(targetARN),
+		}
+
+		_, err = conn.FailoverGlobalCluster(ctx, &input)
+	} else {
+		input := rds.SwitchoverGlobalClusterInput{
+			GlobalClusterIdentifier:   aws.String(globalClusterID),
+			TargetDbClusterIdentifier: aws.String(targetARN),
+		}
+
+		_, err = conn.SwitchoverGlobalCluster(ctx, &input)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Starting RDS global cluster %s", operation), err.Error())
+		return
+	}
+
+	// The last failover state observed, reported if the failover doesn't complete.
+	var failoverState string
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[string], error) {
+		globalCluster, err := findGlobalClusterByID(ctx, conn, globalClusterID)
+		if err != nil {
+			return actionwait.FetchResult[string]{}, err
+		}
+
+		writer, status := globalClusterWriterARN(globalCluster), aws.ToString(globalCluster.Status)
+		switch v := globalCluster.FailoverState; {
+		case v != nil:
+			failoverState = globalClusterFailoverStateDescription(v)
+			if v.Status == awstypes.FailoverStatusCancelling {
+				status = string(v.Status)
+			}
+		case status == globalClusterStatusAvailable && writer == targetARN:
+			status = failoverStatusCompleted
+		case status == globalClusterStatusAvailable && failoverState != "":
+			status = failoverStatusReverted
+		}
+
+		return actionwait.FetchResult[string]{Status: actionwait.Status(status), Value: writer}, nil
+	}, failoverWaitOptions[string](timeout, []actionwait.Status{
+		actionwait.Status(awstypes.FailoverStatusCancelling),
+		failoverStatusReverted,
+	}, resp))
+	if err != nil {
+		var detail string
+		if failoverState != "" {
+			detail = fmt.Sprintf("last failover state: %s", failoverState)
+		}
+		addFailoverWaitError(err, fmt.Sprintf("global cluster %s", globalClusterID), detail, timeout, resp)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Global cluster %s %s completed, writer changed from %s to %s", globalClusterID, operation, a.dbClusterEndpointDescription(ctx, oldWriter), a.dbClusterEndpointDescription(ctx, result.Value)),
+	})
+
+	tflog.Info(ctx, "RDS global cluster failover action completed successfully", map[string]any{
+		"global_cluster_identifier": globalClusterID,
+		"old_writer":                oldWriter,
+		"new_writer":                result.Value,
+	})
+}
+
+func (a *failoverAction) failoverDBInstance(ctx context.Context, config failoverActionModel, timeout time.Duration, resp *action.InvokeResponse) {
+	conn := a.Meta().RDSClient(ctx)
+	instanceID := config.DBInstanceIdentifier.ValueString()
+
+	tflog.Info(ctx, "Starting RDS DB instance failover action", map[string]any{
+		"db_instance_identifier": instanceID,
+		names.AttrTimeout:        timeout.String(),
+	})
+
+	instance, err := findDBInstanceByID(ctx, conn, instanceID)
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.AddError("DB Instance Not Found", fmt.Sprintf("RDS DB instance %s was not found", instanceID))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Describing RDS DB instance", err.Error())
+		return
+	}
+
+	if !aws.ToBool(instance.MultiAZ) {
+		resp.Diagnostics.AddError("DB Instance Not Multi-AZ", fmt.Sprintf("RDS DB instance %s is not a Multi-AZ deployment and cannot be failed over", instanceID))
+		return
+	}
+
+	if status := aws.ToString(instance.DBInstanceStatus); status != instanceStatusAvailable {
+		resp.Diagnostics.AddError("DB Instance Not Available", fmt.Sprintf("RDS DB instance %s is %s, expected %s", instanceID, status, instanceStatusAvailable))
+		return
+	}
+
+	// The instance endpoint is unchanged by a Multi-AZ failover; the primary moves to the standby's Availability Zone.
+	oldAZ := aws.ToString(instance.AvailabilityZone)
+	endpoint := dbInstanceEndpoint(instance)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Rebooting DB instance %s (%s) with failover, current primary is in %s", instanceID, endpoint, oldAZ),
+	})
+
+	input := rds.RebootDBInstanceInput{
+		DBInstanceIdentifier: aws.String(instanceID),
+		ForceFailover:        aws.Bool(true),
+	}
+
+	if _, err := conn.RebootDBInstance(ctx, &input); err != nil {
+		resp.Diagnostics.AddError("Rebooting RDS DB instance", err.Error())
+		return
+	}
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[string], error) {
+		instance, err := findDBInstanceByID(ctx, conn, instanceID)
+		if err != nil {
+			return actionwait.FetchResult[string]{}, err
+		}
+
+		az, status := aws.ToString(instance.AvailabilityZone), aws.ToString(instance.DBInstanceStatus)
+		if status == instanceStatusAvailable && az != oldAZ {
+			status = failoverStatusCompleted
+		}
+
+		return actionwait.FetchResult[string]{Status: actionwait.Status(status), Value: az}, nil
+	}, failoverWaitOptions[string](timeout, []actionwait.Status{
+		instanceStatusFailed,
+		instanceStatusInaccessibleEncryptionCredentials,
+		instanceStatusInaccessibleEncryptionCredentialsRecoverable,
+		instanceStatusStorageFull,
+	}, resp))
+	if err != nil {
+		addFailoverWaitError(err, fmt.Sprintf("DB instance %s", instanceID), "", timeout, resp)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("DB instance %s (%s) failover completed, primary moved from %s to %s", instanceID, endpoint, oldAZ, result.Value),
+	})
+
+	tflog.Info(ctx, "RDS DB instance failover action completed successfully", map[string]any{
+		"db_instance_identifier": instanceID,
+		"old_availability_zone":  oldAZ,
+		"new_availability_zone":  result.Value,
+	})
+}
+
+// dbClusterEndpointDescription returns the specified DB cluster ARN and its writer endpoint.
+// Global cluster members are in other regions, so the cluster is described in its own region.
+func (a *failoverAction) dbClusterEndpointDescription(ctx context.Context, clusterARN string) string {
+	parsedARN, err := arn.Parse(clusterARN)
+	if err != nil {
+		return clusterARN
+	}
+
+	cluster, err := findDBClusterByID(ctx, a.Meta().RDSClient(ctx), clusterARN, func(o *rds.Options) {
+		o.Region = parsedARN.Region
+	})
+	if err != nil {
+		return clusterARN
+	}
+
+	return fmt.Sprintf("%s (%s)", clusterARN, aws.ToString(cluster.Endpoint))
+}
+
+func failoverWaitOptions[T any](timeout time.Duration, failureStates []actionwait.Status, resp *action.InvokeResponse) actionwait.Options[T] {
+	var lastStatus actionwait.Status
+
+	return actionwait.Options[T]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(failoverPollInterval),
+		ProgressInterval: 2 * time.Minute,
+		SuccessStates:    []actionwait.Status{failoverStatusCompleted},
+		FailureStates:    failureStates,
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if fr.Status == lastStatus {
+				return
+			}
+			lastStatus = fr.Status
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Failover in progress, status is %s (elapsed %s)", fr.Status, meta.Elapsed.Round(time.Second)),
+			})
+		},
+	}
+}
+
+// addFailoverWaitError adds a diagnostic for a failover wait error. detail, if non-empty, is appended to timeout and failure messages.
+func addFailoverWaitError(err error, target, detail string, timeout time.Duration, resp *action.InvokeResponse) {
+	if detail != "" {
+		detail = "; " + detail
+	}
+
+	var timeoutErr *actionwait.TimeoutError
+	var failureErr *actionwait.FailureStateError
+	if errors.As(err, &timeoutErr) {
+		resp.Diagnostics.AddError("Failover timeout", fmt.Sprintf("Failover of %s did not complete within %s (last status: %s%s)", target, timeout, timeoutErr.LastStatus, detail))
+	} else if errors.As(err, &failureErr) {
+		resp.Diagnostics.AddError("Failover failed", fmt.Sprintf("Failover of %s failed with status %s%s", target, failureErr.Status, detail))
+	} else {
+		resp.Diagnostics.AddError("Error waiting for failover", err.Error())
+	}
+}
+
+func dbClusterWriterInstanceID(cluster *awstypes.DBCluster) string {
+	for _, member := range cluster.DBClusterMembers {
+		if aws.ToBool(member.IsClusterWriter) {
+			return aws.ToString(member.DBInstanceIdentifier)
+		}
+	}
+
+	return ""
+}
+
+func dbClusterReaderInstanceIDs(cluster *awstypes.DBCluster) []string {
+	var ids []string
+	for _, member := range cluster.DBClusterMembers {
+		if !aws.ToBool(member.IsClusterWriter) {
+			ids = append(ids, aws.ToString(member.DBInstanceIdentifier))
+		}
+	}
+
+	return ids
+}
+
+func globalClusterWriterARN(globalCluster *awstypes.GlobalCluster) string {
+	for _, member := range globalCluster.GlobalClusterMembers {
+		if aws.ToBool(member.IsWriter) {
+			return aws.ToString(member.DBClusterArn)
+		}
+	}
+
+	return ""
+}
+
+// globalClusterFailoverStateDescription returns the status and direction of a global cluster's in-progress failover or switchover.
+func globalClusterFailoverStateDescription(failoverState *awstypes.FailoverState) string {
+	return fmt.Sprintf("%s from %s to %s (data loss allowed: %t)", failoverState.Status, aws.ToString(failoverState.FromDbClusterArn), aws.ToString(failoverState.ToDbClusterArn), aws.ToBool(failoverState.IsDataLossAllowed))
+}
+
+// dbInstanceEndpointDescription returns the specified DB instance identifier and its endpoint.
+func dbInstanceEndpointDescription(ctx context.Context, conn *rds.Client, id string) string {
+	if id == "" {
+		return "(none)"
+	}
+
+	instance, err := findDBInstanceByID(ctx, conn, id)
+	if err != nil {
+		return id
+	}
+
+	return fmt.Sprintf("%s (%s)", id, dbInstanceEndpoint(instance))
+}
+
+func dbInstanceEndpoint(instance *awstypes.DBInstance) string {
+	if instance.Endpoint == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%s:%d", aws.ToString(instance.Endpoint.Address), aws.ToInt32(instance.Endpoint.Port))
+}
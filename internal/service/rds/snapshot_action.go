@@ -0,0 +1,293 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// snapshotActionDefaultIdentifier is the snapshot identifier template used when none is configured.
+	snapshotActionDefaultIdentifier = "{{identifier}}-{{timestamp}}"
+
+	// snapshotActionTimestampFormat is the format of the {{timestamp}} snapshot identifier placeholder.
+	snapshotActionTimestampFormat = "20060102-150405"
+)
+
+// @Action(aws_rds_snapshot, name="Snapshot")
+func newSnapshotAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &snapshotAction{}, nil
+}
+
+var (
+	_ action.Action = (*snapshotAction)(nil)
+)
+
+type snapshotAction struct {
+	framework.ActionWithModel[snapshotActionModel]
+}
+
+type snapshotActionModel struct {
+	framework.WithRegionModel
+	CopyToRegion         types.String        `tfsdk:"copy_to_region"`
+	DBClusterIdentifier  types.String        `tfsdk:"db_cluster_identifier"`
+	DBInstanceIdentifier types.String        `tfsdk:"db_instance_identifier"`
+	KMSKeyID             types.String        `tfsdk:"kms_key_id"`
+	SnapshotIdentifier   types.String        `tfsdk:"snapshot_identifier"`
+	Tags                 fwtypes.MapOfString `tfsdk:"tags"`
+	Timeout              types.Int64         `tfsdk:"timeout"`
+}
+
+func (a *snapshotAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a snapshot of an RDS DB instance or DB cluster and waits for it to become available, optionally copying it to another region.",
+		Attributes: map[string]schema.Attribute{
+			"copy_to_region": schema.StringAttribute{
+				Description: "The region to copy the snapshot to once it is available",
+				Optional:    true,
+			},
+			"db_cluster_identifier": schema.StringAttribute{
+				Description: "The identifier of the DB cluster to snapshot. Exactly one of db_cluster_identifier or db_instance_identifier must be specified",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("db_instance_identifier")),
+				},
+			},
+			"db_instance_identifier": schema.StringAttribute{
+				Description: "The identifier of the DB instance to snapshot. Exactly one of db_cluster_identifier or db_instance_identifier must be specified",
+				Optional:    true,
+			},
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Description: "The KMS key used to encrypt the snapshot copy in copy_to_region. Required to copy an encrypted snapshot",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("copy_to_region")),
+				},
+			},
+			"snapshot_identifier": schema.StringAttribute{
+				Description: "The identifier of the snapshot. The placeholders {{identifier}} (the DB instance or cluster identifier) and {{timestamp}} (the current UTC time) are replaced. Defaults to {{identifier}}-{{timestamp}}",
+				Optional:    true,
+			},
+			names.AttrTags: schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Description: "Tags to assign to the snapshot and its copy",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the snapshot, and its copy, to become available. Defaults to 3600 seconds (60 minutes)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+	}
+}
+
+func (a *snapshotAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config snapshotActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().RDSClient(ctx)
+
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	isCluster := !config.DBClusterIdentifier.IsNull()
+	sourceID, sourceType := config.DBInstanceIdentifier.ValueString(), "DB instance"
+	if isCluster {
+		sourceID, sourceType = config.DBClusterIdentifier.ValueString(), "DB cluster"
+	}
+
+	template := snapshotActionDefaultIdentifier
+	if !config.SnapshotIdentifier.IsNull() {
+		template = config.SnapshotIdentifier.ValueString()
+	}
+	snapshotID := expandSnapshotIdentifierTemplate(template, sourceID, time.Now())
+
+	tags := svcTags(tftags.New(ctx, fwflex.ExpandFrameworkStringValueMap(ctx, config.Tags)).IgnoreAWS())
+
+	tflog.Info(ctx, "Starting RDS snapshot action", map[string]any{
+		"source_identifier":   sourceID,
+		"snapshot_identifier": snapshotID,
+		names.AttrTimeout:     timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Checking that %s %s is available...", sourceType, sourceID),
+	})
+
+	var status, expectedStatus string
+	if isCluster {
+		cluster, err := findDBClusterByID(ctx, conn, sourceID)
+		if tfresource.NotFound(err) {
+			resp.Diagnostics.AddError("DB Cluster Not Found", fmt.Sprintf("RDS DB cluster %s was not found", sourceID))
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Describing RDS DB cluster", err.Error())
+			return
+		}
+		status, expectedStatus = aws.ToString(cluster.Status), clusterStatusAvailable
+	} else {
+		instance, err := findDBInstanceByID(ctx, conn, sourceID)
+		if tfresource.NotFound(err) {
+			resp.Diagnostics.AddError("DB Instance Not Found", fmt.Sprintf("RDS DB instance %s was not found", sourceID))
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Describing RDS DB instance", err.Error())
+			return
+		}
+		status, expectedStatus = aws.ToString(instance.DBInstanceStatus), instanceStatusAvailable
+	}
+
+	// Snapshots can only be taken of available instances and clusters. Refuse rather than
+	// queue a snapshot behind an in-progress modification, or of a stopped database.
+	if status != expectedStatus {
+		resp.Diagnostics.AddError("Source Not Available", fmt.Sprintf("RDS %s %s is %s, expected %s", sourceType, sourceID, status, expectedStatus))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Creating snapshot %s of %s %s...", snapshotID, sourceType, sourceID),
+	})
+
+	var snapshotARN string
+	if isCluster {
+		input := rds.CreateDBClusterSnapshotInput{
+			DBClusterIdentifier:         aws.String(sourceID),
+			DBClusterSnapshotIdentifier: aws.String(snapshotID),
+			Tags:                        tags,
+		}
+
+		if _, err := conn.CreateDBClusterSnapshot(ctx, &input); err != nil {
+			resp.Diagnostics.AddError("Creating RDS DB cluster snapshot", err.Error())
+			return
+		}
+
+		snapshot, err := waitDBClusterSnapshotCreated(ctx, conn, snapshotID, timeout)
+		if err != nil {
+			resp.Diagnostics.AddError("Waiting for RDS DB cluster snapshot", err.Error())
+			return
+		}
+		snapshotARN = aws.ToString(snapshot.DBClusterSnapshotArn)
+	} else {
+		input := rds.CreateDBSnapshotInput{
+			DBInstanceIdentifier: aws.String(sourceID),
+			DBSnapshotIdentifier: aws.String(snapshotID),
+			Tags:                 tags,
+		}
+
+		if _, err := conn.CreateDBSnapshot(ctx, &input); err != nil {
+			resp.Diagnostics.AddError("Creating RDS DB snapshot", err.Error())
+			return
+		}
+
+		snapshot, err := waitDBSnapshotCreated(ctx, conn, snapshotID, timeout)
+		if err != nil {
+			resp.Diagnostics.AddError("Waiting for RDS DB snapshot", err.Error())
+			return
+		}
+		snapshotARN = aws.ToString(snapshot.DBSnapshotArn)
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Snapshot %s is available", snapshotARN),
+	})
+
+	if !config.CopyToRegion.IsNull() {
+		region := config.CopyToRegion.ValueString()
+		optFn := func(o *rds.Options) {
+			o.Region = region
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Copying snapshot %s to %s...", snapshotID, region),
+		})
+
+		// Setting SourceRegion has the SDK generate the pre-signed URL required for cross-region copies.
+		if isCluster {
+			input := rds.CopyDBClusterSnapshotInput{
+				KmsKeyId:                          fwflex.StringFromFramework(ctx, config.KMSKeyID),
+				SourceDBClusterSnapshotIdentifier: aws.String(snapshotARN),
+				SourceRegion:                      aws.String(a.Meta().Region(ctx)),
+				Tags:                              tags,
+				TargetDBClusterSnapshotIdentifier: aws.String(snapshotID),
+			}
+
+			if _, err := conn.CopyDBClusterSnapshot(ctx, &input, optFn); err != nil {
+				resp.Diagnostics.AddError("Copying RDS DB cluster snapshot", err.Error())
+				return
+			}
+
+			if _, err := waitDBClusterSnapshotCreated(ctx, conn, snapshotID, timeout, optFn); err != nil {
+				resp.Diagnostics.AddError("Waiting for RDS DB cluster snapshot copy", err.Error())
+				return
+			}
+		} else {
+			input := rds.CopyDBSnapshotInput{
+				KmsKeyId:                   fwflex.StringFromFramework(ctx, config.KMSKeyID),
+				SourceDBSnapshotIdentifier: aws.String(snapshotARN),
+				SourceRegion:               aws.String(a.Meta().Region(ctx)),
+				Tags:                       tags,
+				TargetDBSnapshotIdentifier: aws.String(snapshotID),
+			}
+
+			if _, err := conn.CopyDBSnapshot(ctx, &input, optFn); err != nil {
+				resp.Diagnostics.AddError("Copying RDS DB snapshot", err.Error())
+				return
+			}
+
+			if _, err := waitDBSnapshotCreated(ctx, conn, snapshotID, timeout, optFn); err != nil {
+				resp.Diagnostics.AddError("Waiting for RDS DB snapshot copy", err.Error())
+				return
+			}
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Snapshot copy %s is available in %s", snapshotID, region),
+		})
+	}
+
+	tflog.Info(ctx, "RDS snapshot action completed successfully", map[string]any{
+		"source_identifier": sourceID,
+		"snapshot_arn":      snapshotARN,
+	})
+}
+
+// expandSnapshotIdentifierTemplate replaces the {{identifier}} and {{timestamp}} placeholders in a snapshot identifier template.
+func expandSnapshotIdentifierTemplate(template, identifier string, now time.Time) string {
+	return strings.NewReplacer(
+		"{{identifier}}", identifier,
+		"{{timestamp}}", now.UTC().Format(snapshotActionTimestampFormat),
+	).Replace(template)
+}
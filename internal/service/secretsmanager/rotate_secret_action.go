@@ -0,0 +1,341 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// rotateSecretPollInterval defines polling cadence for the rotate secret action.
+const rotateSecretPollInterval = 10 * time.Second
+
+// Synthetic statuses describing the progress of the secret version created by a rotation.
+const (
+	rotationStatusCreating  = "CREATING"
+	rotationStatusPending   = "PENDING"
+	rotationStatusCurrent   = "CURRENT"
+	rotationStatusAbandoned = "ABANDONED"
+	rotationStatusFailed    = "FAILED"
+)
+
+// rotationFunctionErrorFilterPattern matches the errors logged by a rotation Lambda function for a secret version.
+// A rotation function can rotate several secrets, so only errors that mention the version's ClientRequestToken are matched.
+const rotationFunctionErrorFilterPattern = `ERROR "%s"`
+
+// @Action(aws_secretsmanager_rotate_secret, name="Rotate Secret")
+func newRotateSecretAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &rotateSecretAction{}, nil
+}
+
+var (
+	_ action.Action = (*rotateSecretAction)(nil)
+)
+
+type rotateSecretAction struct {
+	framework.ActionWithModel[rotateSecretActionModel]
+}
+
+type rotateSecretActionModel struct {
+	framework.WithRegionModel
+	RotateImmediately types.Bool   `tfsdk:"rotate_immediately"`
+	SecretID          types.String `tfsdk:"secret_id"`
+	Timeout           types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *rotateSecretAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rotates a Secrets Manager secret and waits for the new secret version to be promoted to AWSCURRENT. The action fails if the rotation function does not finish the rotation.",
+		Attributes: map[string]schema.Attribute{
+			"rotate_immediately": schema.BoolAttribute{
+				Description: "Whether to rotate the secret immediately. If false, only the testSecret step of the rotation function is run and the secret is rotated in the next rotation window. Defaults to true",
+				Optional:    true,
+			},
+			"secret_id": schema.StringAttribute{
+				Description: "The ARN or name of the secret to rotate",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the rotation to finish. Defaults to 1800 seconds (30 minutes)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+	}
+}
+
+func (a *rotateSecretAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config rotateSecretActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SecretsManagerClient(ctx)
+
+	secretID := config.SecretID.ValueString()
+
+	rotateImmediately := true
+	if !config.RotateImmediately.IsNull() {
+		rotateImmediately = config.RotateImmediately.ValueBool()
+	}
+
+	timeout := 30 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting Secrets Manager rotate secret action", map[string]any{
+		"secret_id":          secretID,
+		"rotate_immediately": rotateImmediately,
+		names.AttrTimeout:    timeout.String(),
+	})
+
+	secret, err := findSecretByID(ctx, conn, secretID)
+	if err != nil {
+		if tfresource.NotFound(err) {
+			resp.Diagnostics.AddError("Secret Not Found", fmt.Sprintf("Secrets Manager secret %s was not found", secretID))
+			return
+		}
+
+		resp.Diagnostics.AddError("Describing Secrets Manager secret", err.Error())
+		return
+	}
+
+	if aws.ToString(secret.RotationLambdaARN) == "" && aws.ToString(secret.OwningService) == "" {
+		resp.Diagnostics.AddError("Secret Rotation Not Configured", fmt.Sprintf("Secrets Manager secret %s has no rotation function; configure rotation with the aws_secretsmanager_secret_rotation resource", secretID))
+		return
+	}
+
+	logGroup := rotationLogGroupName(aws.ToString(secret.RotationLambdaARN))
+
+	rotationStart := time.Now()
+
+	input := secretsmanager.RotateSecretInput{
+		RotateImmediately: aws.Bool(rotateImmediately),
+		SecretId:          aws.String(secretID),
+	}
+
+	output, err := conn.RotateSecret(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError("Rotating Secrets Manager secret", err.Error())
+		return
+	}
+
+	secretARN := aws.ToString(output.ARN)
+
+	if !rotateImmediately {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Rotation configuration of secret %s validated, the secret will be rotated in its next rotation window", secretARN),
+		})
+
+		tflog.Info(ctx, "Secrets Manager rotate secret action completed successfully", map[string]any{
+			"secret_arn": secretARN,
+		})
+		return
+	}
+
+	versionID := aws.ToString(output.VersionId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Rotation of secret %s started, creating version %s...", secretARN, versionID),
+	})
+
+	var lastStatus actionwait.Status
+	// A failing rotation function leaves the version labelled AWSPENDING, so its logs are checked for errors while waiting.
+	var functionError string
+
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[[]string], error) {
+		secret, err := findSecretByID(ctx, conn, secretARN)
+		if err != nil {
+			return actionwait.FetchResult[[]string]{}, err
+		}
+
+		stages, ok := secret.VersionIdsToStages[versionID]
+		status := rotationVersionStatus(stages, ok, lastStatus)
+
+		if logGroup != "" && (status == rotationStatusCreating || status == rotationStatusPending) {
+			message, err := findRotationFunctionError(ctx, a.Meta().LogsClient(ctx), logGroup, versionID, rotationStart)
+			if err != nil {
+				// The log group may not exist yet or may not be readable, which doesn't fail the rotation.
+				tflog.Debug(ctx, "Reading Secrets Manager rotation function logs", map[string]any{
+					"log_group": logGroup,
+					"error":     err.Error(),
+				})
+			}
+			if message != "" {
+				functionError = message
+				status = rotationStatusFailed
+			}
+		}
+
+		if status != lastStatus {
+			lastStatus = status
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: rotationProgressMessage(secretARN, versionID, status),
+			})
+		}
+
+		return actionwait.FetchResult[[]string]{Status: status, Value: stages}, nil
+	}, actionwait.Options[[]string]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(rotateSecretPollInterval),
+		ProgressInterval: 2 * time.Minute,
+		SuccessStates: []actionwait.Status{
+			rotationStatusCurrent,
+		},
+		TransitionalStates: []actionwait.Status{
+			rotationStatusCreating,
+			rotationStatusPending,
+		},
+		FailureStates: []actionwait.Status{
+			rotationStatusAbandoned,
+			rotationStatusFailed,
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Secret %s version %s is %s (elapsed %s)", secretARN, versionID, fr.Status, meta.Elapsed.Round(time.Second)),
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError("Secret rotation timeout", rotationFailureDetail(fmt.Sprintf("Secret %s version %s was not promoted to %s within %s (last status: %s)", secretARN, versionID, secretVersionStageCurrent, timeout, timeoutErr.LastStatus), logGroup))
+		} else if errors.As(err, &failureErr) {
+			if failureErr.Status == rotationStatusFailed {
+				resp.Diagnostics.AddError("Secret rotation failed", rotationFailureDetail(fmt.Sprintf("The rotation function of secret %s failed to rotate version %s: %s", secretARN, versionID, functionError), logGroup))
+			} else {
+				resp.Diagnostics.AddError("Secret rotation failed", rotationFailureDetail(fmt.Sprintf("Secret %s version %s was removed before it was promoted to %s", secretARN, versionID, secretVersionStageCurrent), logGroup))
+			}
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected secret version status", err.Error())
+		} else {
+			resp.Diagnostics.AddError("Error waiting for secret rotation", err.Error())
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Secret %s rotated, version %s is %s", secretARN, versionID, secretVersionStageCurrent),
+	})
+
+	tflog.Info(ctx, "Secrets Manager rotate secret action completed successfully", map[string]any{
+		"secret_arn": secretARN,
+		"version_id": versionID,
+	})
+}
+
+// rotationVersionStatus maps the staging labels of the version created by a rotation to a rotation status.
+// The version is created by the rotation function's createSecret step, labelled AWSPENDING, and
+// moved to AWSCURRENT by its finishSecret step.
+func rotationVersionStatus(stages []string, exists bool, lastStatus actionwait.Status) actionwait.Status {
+	switch {
+	case slices.Contains(stages, secretVersionStageCurrent):
+		return rotationStatusCurrent
+	case slices.Contains(stages, secretVersionStagePending):
+		return rotationStatusPending
+	case lastStatus == rotationStatusPending:
+		// The version lost its AWSPENDING label without becoming current.
+		return rotationStatusAbandoned
+	case exists && len(stages) > 0:
+		// The version was already labelled, e.g. AWSPREVIOUS, by a concurrent rotation.
+		return rotationStatusAbandoned
+	default:
+		return rotationStatusCreating
+	}
+}
+
+func rotationProgressMessage(secretARN, versionID string, status actionwait.Status) string {
+	switch status {
+	case rotationStatusCreating:
+		return fmt.Sprintf("Secret %s version %s is being created by the createSecret step", secretARN, versionID)
+	case rotationStatusPending:
+		return fmt.Sprintf("Secret %s version %s is %s, running the setSecret, testSecret and finishSecret steps", secretARN, versionID, secretVersionStagePending)
+	case rotationStatusCurrent:
+		return fmt.Sprintf("Secret %s version %s is %s", secretARN, versionID, secretVersionStageCurrent)
+	default:
+		return fmt.Sprintf("Secret %s version %s is %s", secretARN, versionID, status)
+	}
+}
+
+// findRotationFunctionError returns the message of the first error logged by a rotation function for the specified secret version
+// since the specified time, or "" if there is none.
+func findRotationFunctionError(ctx context.Context, conn *cloudwatchlogs.Client, logGroup, versionID string, since time.Time) (string, error) {
+	input := cloudwatchlogs.FilterLogEventsInput{
+		FilterPattern: aws.String(fmt.Sprintf(rotationFunctionErrorFilterPattern, versionID)),
+		LogGroupName:  aws.String(logGroup),
+		StartTime:     aws.Int64(since.UnixMilli()),
+	}
+
+	pages := cloudwatchlogs.NewFilterLogEventsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return "", err
+		}
+
+		// The log group is checked on every poll, so don't scan the rest of a large log group for events that aren't there yet.
+		if len(page.Events) == 0 {
+			break
+		}
+
+		for _, v := range page.Events {
+			if message := strings.TrimSpace(aws.ToString(v.Message)); message != "" {
+				return message, nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// rotationLogGroupName returns the CloudWatch Logs log group of a rotation Lambda function.
+func rotationLogGroupName(lambdaARN string) string {
+	v, err := arn.Parse(lambdaARN)
+	if err != nil {
+		return ""
+	}
+
+	// The resource is "function:<name>", optionally followed by ":<qualifier>".
+	parts := strings.Split(v.Resource, ":")
+	if len(parts) < 2 || parts[0] != "function" {
+		return ""
+	}
+
+	return "/aws/lambda/" + parts[1]
+}
+
+func rotationFailureDetail(message, logGroup string) string {
+	if logGroup == "" {
+		return message
+	}
+
+	return fmt.Sprintf("%s. Check the rotation function's logs in CloudWatch Logs log group %s", message, logGroup)
+}
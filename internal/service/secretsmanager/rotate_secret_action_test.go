@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecretsmanager "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSecretsManagerRotateSecretAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckSecretDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotateSecretActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotateSecretActionRotated(ctx, "aws_secretsmanager_secret_version.test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerRotateSecretAction_rotationFailed(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckSecretDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRotateSecretActionConfig_rotationFailed(rName),
+				ExpectError: regexache.MustCompile(`(?s)Secret rotation failed.*createSecret failed`),
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerRotateSecretAction_secretNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRotateSecretActionConfig_secretNotFound(),
+				ExpectError: regexache.MustCompile(`Secret Not Found`),
+			},
+		},
+	})
+}
+
+// testAccCheckRotateSecretActionRotated checks that AWSCURRENT has moved away from the secret version created by Terraform.
+func testAccCheckRotateSecretActionRotated(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecretsManagerClient(ctx)
+
+		output, err := tfsecretsmanager.FindSecretByID(ctx, conn, rs.Primary.Attributes["secret_id"])
+
+		if err != nil {
+			return err
+		}
+
+		if slices.Contains(output.VersionIdsToStages[rs.Primary.Attributes["version_id"]], "AWSCURRENT") {
+			return fmt.Errorf("Secrets Manager Secret %s was not rotated", rs.Primary.Attributes["secret_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccRotateSecretActionConfig_base(rName, failStep string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "lambda.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Statement = [{
+      Action = [
+        "secretsmanager:DescribeSecret",
+        "secretsmanager:GetSecretValue",
+        "secretsmanager:PutSecretValue",
+        "secretsmanager:UpdateSecretVersionStage",
+      ]
+      Effect   = "Allow"
+      Resource = aws_secretsmanager_secret.test.arn
+      }, {
+      Action = [
+        "logs:CreateLogGroup",
+        "logs:CreateLogStream",
+        "logs:PutLogEvents",
+      ]
+      Effect   = "Allow"
+      Resource = "arn:${data.aws_partition.current.partition}:logs:*:*:*"
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/rotation.zip"
+  function_name = %[1]q
+  handler       = "index.handler"
+  role          = aws_iam_role.test.arn
+  runtime       = "python3.12"
+
+  environment {
+    variables = {
+      FAIL_STEP = %[2]q
+    }
+  }
+}
+
+resource "aws_lambda_permission" "test" {
+  action        = "lambda:InvokeFunction"
+  function_name = aws_lambda_function.test.function_name
+  principal     = "secretsmanager.amazonaws.com"
+  statement_id  = "AllowExecutionFromSecretsManager"
+}
+
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = "test-string"
+}
+
+resource "aws_secretsmanager_secret_rotation" "test" {
+  secret_id           = aws_secretsmanager_secret.test.id
+  rotation_lambda_arn = aws_lambda_function.test.arn
+  rotate_immediately  = false
+
+  rotation_rules {
+    automatically_after_days = 7
+  }
+
+  depends_on = [
+    aws_iam_role_policy.test,
+    aws_lambda_permission.test,
+    aws_secretsmanager_secret_version.test,
+  ]
+}
+`, rName, failStep)
+}
+
+func testAccRotateSecretActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccRotateSecretActionConfig_base(rName, ""), `
+action "aws_secretsmanager_rotate_secret" "test" {
+  config {
+    secret_id = aws_secretsmanager_secret.test.id
+    timeout   = 600
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_secretsmanager_rotate_secret.test]
+    }
+  }
+
+  depends_on = [aws_secretsmanager_secret_rotation.test]
+}
+`)
+}
+
+func testAccRotateSecretActionConfig_rotationFailed(rName string) string {
+	return acctest.ConfigCompose(testAccRotateSecretActionConfig_base(rName, "createSecret"), `
+action "aws_secretsmanager_rotate_secret" "test" {
+  config {
+    secret_id = aws_secretsmanager_secret.test.id
+    timeout   = 60
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_secretsmanager_rotate_secret.test]
+    }
+  }
+
+  depends_on = [aws_secretsmanager_secret_rotation.test]
+}
+`)
+}
+
+func testAccRotateSecretActionConfig_secretNotFound() string {
+	return `
+action "aws_secretsmanager_rotate_secret" "test" {
+  config {
+    secret_id = "tf-acc-test-not-found"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_secretsmanager_rotate_secret.test]
+    }
+  }
+}
+`
+}
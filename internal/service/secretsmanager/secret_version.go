@@ -29,6 +29,7 @@ import (
 
 const (
 	secretVersionStageCurrent  = "AWSCURRENT"
+	secretVersionStagePending  = "AWSPENDING"
 	secretVersionStagePrevious = "AWSPREVIOUS"
 )
 
@@ -0,0 +1,31 @@
+# Minimal Secrets Manager rotation function for acceptance tests.
+# Set the FAIL_STEP environment variable to make a rotation step fail.
+import os
+import uuid
+
+import boto3
+
+
+def handler(event, context):
+    step = event["Step"]
+    secret_id = event["SecretId"]
+    token = event["ClientRequestToken"]
+
+    if os.environ.get("FAIL_STEP") == step:
+        raise Exception(f"{step} failed for secret {secret_id} version {token}")
+
+    client = boto3.client("secretsmanager")
+
+    if step == "createSecret":
+        try:
+            client.get_secret_value(SecretId=secret_id, VersionId=token, VersionStage="AWSPENDING")
+        except client.exceptions.ResourceNotFoundException:
+            client.put_secret_value(SecretId=secret_id, ClientRequestToken=token, SecretString=str(uuid.uuid4()), VersionStages=["AWSPENDING"])
+    elif step == "finishSecret":
+        metadata = client.describe_secret(SecretId=secret_id)
+        for version, stages in metadata["VersionIdsToStages"].items():
+            if "AWSCURRENT" in stages:
+                if version == token:
+                    return
+                client.update_secret_version_stage(SecretId=secret_id, VersionStage="AWSCURRENT", MoveToVersionId=token, RemoveFromVersionId=version)
+                return
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @Action(aws_sqs_purge_queue, name="Purge Queue")
+func newPurgeQueueAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &purgeQueueAction{}, nil
+}
+
+var (
+	_ action.Action = (*purgeQueueAction)(nil)
+)
+
+type purgeQueueAction struct {
+	framework.ActionWithModel[purgeQueueActionModel]
+}
+
+type purgeQueueActionModel struct {
+	framework.WithRegionModel
+	QueueURL types.String `tfsdk:"queue_url"`
+}
+
+func (a *purgeQueueAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Deletes all messages in an Amazon SQS queue. Only one purge is allowed per queue every 60 seconds.",
+		Attributes: map[string]schema.Attribute{
+			"queue_url": schema.StringAttribute{
+				Description: "The URL of the queue to purge",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (a *purgeQueueAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config purgeQueueActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SQSClient(ctx)
+
+	queueURL := config.QueueURL.ValueString()
+
+	tflog.Info(ctx, "Starting SQS purge queue action", map[string]any{
+		"queue_url": queueURL,
+	})
+
+	attributes, err := findQueueAttributesByURL(ctx, conn, queueURL)
+	if err != nil {
+		if tfresource.NotFound(err) {
+			resp.Diagnostics.AddError("Queue Not Found", fmt.Sprintf("SQS queue %s was not found", queueURL))
+			return
+		}
+
+		resp.Diagnostics.AddError("Describing SQS queue", err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Purging SQS queue %s (approximately %s visible, %s in flight and %s delayed message(s))...", queueURL,
+			attributes[awstypes.QueueAttributeNameApproximateNumberOfMessages],
+			attributes[awstypes.QueueAttributeNameApproximateNumberOfMessagesNotVisible],
+			attributes[awstypes.QueueAttributeNameApproximateNumberOfMessagesDelayed],
+		),
+	})
+
+	input := sqs.PurgeQueueInput{
+		QueueUrl: aws.String(queueURL),
+	}
+
+	_, err = conn.PurgeQueue(ctx, &input)
+
+	if errs.IsA[*awstypes.PurgeQueueInProgress](err) {
+		resp.Diagnostics.AddError("Purge Already In Progress", fmt.Sprintf("SQS queue %s was purged within the last 60 seconds. Only one purge is allowed per queue every 60 seconds; retry after the cooldown has elapsed.", queueURL))
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Purging SQS queue", fmt.Sprintf("Could not purge SQS queue %s: %s", queueURL, err))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("SQS queue %s purged. Message deletion can take up to 60 seconds to complete", queueURL),
+	})
+
+	tflog.Info(ctx, "SQS purge queue action completed successfully", map[string]any{
+		"queue_url": queueURL,
+	})
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSQSPurgeQueueAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_sqs_queue.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSendMessageActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueApproximateNumberOfMessages(ctx, resourceName, 1),
+				),
+			},
+			{
+				Config: testAccPurgeQueueActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueApproximateNumberOfMessages(ctx, resourceName, 0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSQSPurgeQueueAction_purgeInProgress(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPurgeQueueActionConfig_twice(rName),
+				ExpectError: regexache.MustCompile(`Purge Already In Progress`),
+			},
+		},
+	})
+}
+
+func TestAccSQSPurgeQueueAction_queueNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPurgeQueueActionConfig_queueNotFound(),
+				ExpectError: regexache.MustCompile(`Queue Not Found`),
+			},
+		},
+	})
+}
+
+func testAccPurgeQueueActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccSendMessageActionConfig_basic(rName), `
+action "aws_sqs_purge_queue" "test" {
+  config {
+    queue_url = aws_sqs_queue.test.url
+  }
+}
+
+resource "terraform_data" "purge" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_sqs_purge_queue.test]
+    }
+  }
+
+  depends_on = [terraform_data.trigger]
+}
+`)
+}
+
+func testAccPurgeQueueActionConfig_twice(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+action "aws_sqs_purge_queue" "test" {
+  config {
+    queue_url = aws_sqs_queue.test.url
+  }
+}
+
+resource "terraform_data" "first" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_sqs_purge_queue.test]
+    }
+  }
+
+  depends_on = [aws_sqs_queue.test]
+}
+
+resource "terraform_data" "second" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_sqs_purge_queue.test]
+    }
+  }
+
+  depends_on = [terraform_data.first]
+}
+`, rName)
+}
+
+func testAccPurgeQueueActionConfig_queueNotFound() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+action "aws_sqs_purge_queue" "test" {
+  config {
+    queue_url = "https://sqs.${data.aws_region.current.region}.${data.aws_partition.current.dns_suffix}/${data.aws_caller_identity.current.account_id}/tf-acc-test-not-found"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_sqs_purge_queue.test]
+    }
+  }
+}
+`
+}
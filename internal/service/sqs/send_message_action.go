@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// sendMessageBatchMaxEntries is the maximum number of messages in a SendMessageBatch request.
+const sendMessageBatchMaxEntries = 10
+
+// @Action(aws_sqs_send_message, name="Send Message")
+func newSendMessageAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &sendMessageAction{}, nil
+}
+
+var (
+	_ action.Action = (*sendMessageAction)(nil)
+)
+
+type sendMessageAction struct {
+	framework.ActionWithModel[sendMessageActionModel]
+}
+
+type sendMessageActionModel struct {
+	framework.WithRegionModel
+	Messages fwtypes.ListNestedObjectValueOf[messageModel] `tfsdk:"message"`
+	QueueURL types.String                                  `tfsdk:"queue_url"`
+}
+
+type messageModel struct {
+	DelaySeconds           types.Int32                                            `tfsdk:"delay_seconds"`
+	MessageAttributes      fwtypes.ListNestedObjectValueOf[messageAttributeModel] `tfsdk:"message_attributes"`
+	MessageBody            types.String                                           `tfsdk:"message_body"`
+	MessageDeduplicationID types.String                                           `tfsdk:"message_deduplication_id"`
+	MessageGroupID         types.String                                           `tfsdk:"message_group_id"`
+}
+
+type messageAttributeModel struct {
+	MapBlockKey types.String `tfsdk:"map_block_key"`
+	DataType    types.String `tfsdk:"data_type"`
+	StringValue types.String `tfsdk:"string_value"`
+}
+
+func (a *sendMessageAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sends one or more messages to an Amazon SQS queue. Up to 10 messages are sent in a single batch.",
+		Attributes: map[string]schema.Attribute{
+			"queue_url": schema.StringAttribute{
+				Description: "The URL of the queue to send the messages to",
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrMessage: schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[messageModel](ctx),
+				Description: "A message to send. Multiple messages are sent as a batch",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, sendMessageBatchMaxEntries),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"delay_seconds": schema.Int32Attribute{
+							Description: "The number of seconds to delay the message. Not supported for FIFO queues",
+							Optional:    true,
+							Validators: []validator.Int32{
+								int32validator.Between(0, 900),
+							},
+						},
+						"message_body": schema.StringAttribute{
+							Description: "The message to send",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"message_deduplication_id": schema.StringAttribute{
+							Description: "The token used to deduplicate messages sent to a FIFO queue",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(128),
+							},
+						},
+						"message_group_id": schema.StringAttribute{
+							Description: "The tag that specifies the message group that the message belongs to. Required for FIFO queues",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(128),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"message_attributes": schema.ListNestedBlock{
+							CustomType:  fwtypes.NewListNestedObjectTypeOf[messageAttributeModel](ctx),
+							Description: "Message attributes to include with the message. Each block represents one attribute where map_block_key becomes the attribute name",
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(10),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{ // nosemgrep:ci.semgrep.framework.map_block_key-meaningful-names
+									"data_type": schema.StringAttribute{
+										Description: "The data type of the message attribute. Valid values are String and Number, optionally followed by a custom type such as Number.float",
+										Required:    true,
+										Validators: []validator.String{
+											stringvalidator.RegexMatches(regexache.MustCompile(`^(String|Number)(\..+)?$`), "must be String or Number, optionally followed by a custom type"),
+										},
+									},
+									"map_block_key": schema.StringAttribute{
+										Description: "The name of the message attribute (used as map key)",
+										Required:    true,
+									},
+									"string_value": schema.StringAttribute{
+										Description: "The value of the message attribute",
+										Required:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *sendMessageAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config sendMessageActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SQSClient(ctx)
+
+	queueURL := config.QueueURL.ValueString()
+
+	messages, diags := config.Messages.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Starting SQS send message action", map[string]any{
+		"queue_url": queueURL,
+		"messages":  len(messages),
+	})
+
+	if _, err := findQueueAttributeByTwoPartKey(ctx, conn, queueURL, awstypes.QueueAttributeNameQueueArn); err != nil {
+		if tfresource.NotFound(err) {
+			resp.Diagnostics.AddError("Queue Not Found", fmt.Sprintf("SQS queue %s was not found", queueURL))
+			return
+		}
+
+		resp.Diagnostics.AddError("Describing SQS queue", err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Sending %d message(s) to SQS queue %s...", len(messages), queueURL),
+	})
+
+	entries := make([]awstypes.SendMessageBatchRequestEntry, 0, len(messages))
+	for i, message := range messages {
+		entry, diags := expandSendMessageBatchRequestEntry(ctx, strconv.Itoa(i), message)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if len(entries) == 1 {
+		entry := entries[0]
+		input := sqs.SendMessageInput{
+			DelaySeconds:           entry.DelaySeconds,
+			MessageAttributes:      entry.MessageAttributes,
+			MessageBody:            entry.MessageBody,
+			MessageDeduplicationId: entry.MessageDeduplicationId,
+			MessageGroupId:         entry.MessageGroupId,
+			QueueUrl:               aws.String(queueURL),
+		}
+
+		output, err := conn.SendMessage(ctx, &input)
+		if err != nil {
+			resp.Diagnostics.AddError("Sending SQS message", fmt.Sprintf("Could not send message to SQS queue %s: %s", queueURL, err))
+			return
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Message sent to SQS queue %s (Message ID: %s)", queueURL, aws.ToString(output.MessageId)),
+		})
+	} else {
+		input := sqs.SendMessageBatchInput{
+			Entries:  entries,
+			QueueUrl: aws.String(queueURL),
+		}
+
+		output, err := conn.SendMessageBatch(ctx, &input)
+		if err != nil {
+			resp.Diagnostics.AddError("Sending SQS message batch", fmt.Sprintf("Could not send messages to SQS queue %s: %s", queueURL, err))
+			return
+		}
+
+		for _, v := range output.Successful {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Message %s sent to SQS queue %s (Message ID: %s)", aws.ToString(v.Id), queueURL, aws.ToString(v.MessageId)),
+			})
+		}
+
+		if len(output.Failed) > 0 {
+			failures := make([]string, 0, len(output.Failed))
+			for _, v := range output.Failed {
+				failures = append(failures, fmt.Sprintf("message %s: %s: %s", aws.ToString(v.Id), aws.ToString(v.Code), aws.ToString(v.Message)))
+			}
+
+			resp.Diagnostics.AddError("Sending SQS message batch", fmt.Sprintf("%d of %d message(s) could not be sent to SQS queue %s: %s", len(output.Failed), len(entries), queueURL, strings.Join(failures, "; ")))
+			return
+		}
+	}
+
+	tflog.Info(ctx, "SQS send message action completed successfully", map[string]any{
+		"queue_url": queueURL,
+		"messages":  len(entries),
+	})
+}
+
+func expandSendMessageBatchRequestEntry(ctx context.Context, id string, message *messageModel) (awstypes.SendMessageBatchRequestEntry, diag.Diagnostics) {
+	entry := awstypes.SendMessageBatchRequestEntry{
+		DelaySeconds:           message.DelaySeconds.ValueInt32(),
+		Id:                     aws.String(id),
+		MessageBody:            message.MessageBody.ValueStringPointer(),
+		MessageDeduplicationId: message.MessageDeduplicationID.ValueStringPointer(),
+		MessageGroupId:         message.MessageGroupID.ValueStringPointer(),
+	}
+
+	attributes, diags := message.MessageAttributes.ToSlice(ctx)
+	if diags.HasError() {
+		return entry, diags
+	}
+
+	if len(attributes) > 0 {
+		entry.MessageAttributes = make(map[string]awstypes.MessageAttributeValue, len(attributes))
+		for _, v := range attributes {
+			entry.MessageAttributes[v.MapBlockKey.ValueString()] = awstypes.MessageAttributeValue{
+				DataType:    v.DataType.ValueStringPointer(),
+				StringValue: v.StringValue.ValueStringPointer(),
+			}
+		}
+	}
+
+	return entry, diags
+}
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSQSSendMessageAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_sqs_queue.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSendMessageActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueApproximateNumberOfMessages(ctx, resourceName, 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSQSSendMessageAction_batchFIFO(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_sqs_queue.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSendMessageActionConfig_batchFIFO(rName),
+				Check: resource.ComposeTestCheckFunc(
+					// The third message has the same deduplication ID as the first.
+					testAccCheckQueueApproximateNumberOfMessages(ctx, resourceName, 2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSQSSendMessageAction_queueNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSendMessageActionConfig_queueNotFound(),
+				ExpectError: regexache.MustCompile(`Queue Not Found`),
+			},
+		},
+	})
+}
+
+func testAccCheckQueueApproximateNumberOfMessages(ctx context.Context, n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SQSClient(ctx)
+
+		// ApproximateNumberOfMessages is eventually consistent.
+		_, err := tfresource.RetryUntilEqual(ctx, 2*time.Minute, strconv.Itoa(expected), func(ctx context.Context) (string, error) {
+			output, err := tfsqs.FindQueueAttributesByURL(ctx, conn, rs.Primary.ID)
+
+			if err != nil {
+				return "", err
+			}
+
+			return output[types.QueueAttributeNameApproximateNumberOfMessages], nil
+		})
+
+		if err != nil {
+			return fmt.Errorf("SQS Queue (%s) ApproximateNumberOfMessages: %w", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccSendMessageActionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+action "aws_sqs_send_message" "test" {
+  config {
+    queue_url = aws_sqs_queue.test.url
+
+    message {
+      message_body = "Hello from Terraform"
+
+      message_attributes {
+        map_block_key = "source"
+        data_type     = "String"
+        string_value  = "terraform"
+      }
+
+      message_attributes {
+        map_block_key = "priority"
+        data_type     = "Number"
+        string_value  = "1"
+      }
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_sqs_send_message.test]
+    }
+  }
+
+  depends_on = [aws_sqs_queue.test]
+}
+`, rName)
+}
+
+func testAccSendMessageActionConfig_batchFIFO(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name       = "%[1]s.fifo"
+  fifo_queue = true
+}
+
+action "aws_sqs_send_message" "test" {
+  config {
+    queue_url = aws_sqs_queue.test.url
+
+    message {
+      message_body             = "first"
+      message_deduplication_id = "first"
+      message_group_id         = "test"
+    }
+
+    message {
+      message_body             = "second"
+      message_deduplication_id = "second"
+      message_group_id         = "test"
+    }
+
+    message {
+      message_body             = "duplicate"
+      message_deduplication_id = "first"
+      message_group_id         = "test"
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_sqs_send_message.test]
+    }
+  }
+
+  depends_on = [aws_sqs_queue.test]
+}
+`, rName)
+}
+
+func testAccSendMessageActionConfig_queueNotFound() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+action "aws_sqs_send_message" "test" {
+  config {
+    queue_url = "https://sqs.${data.aws_region.current.region}.${data.aws_partition.current.dns_suffix}/${data.aws_caller_identity.current.account_id}/tf-acc-test-not-found"
+
+    message {
+      message_body = "Hello from Terraform"
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_sqs_send_message.test]
+    }
+  }
+}
+`
+}
@@ -0,0 +1,398 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// sendCommandPollInterval defines polling cadence for the send command action.
+const sendCommandPollInterval = 5 * time.Second
+
+// sendCommandOutputMaxLength is the maximum length of command output reported in progress messages.
+const sendCommandOutputMaxLength = 1024
+
+// @Action(aws_ssm_send_command, name="Send Command")
+func newSendCommandAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &sendCommandAction{}, nil
+}
+
+var (
+	_ action.Action = (*sendCommandAction)(nil)
+)
+
+type sendCommandAction struct {
+	framework.ActionWithModel[sendCommandActionModel]
+}
+
+type sendCommandActionModel struct {
+	framework.WithRegionModel
+	Comment         types.String                                 `tfsdk:"comment"`
+	DocumentName    types.String                                 `tfsdk:"document_name"`
+	DocumentVersion types.String                                 `tfsdk:"document_version"`
+	InstanceIDs     fwtypes.SetOfString                          `tfsdk:"instance_ids"`
+	MaxConcurrency  types.String                                 `tfsdk:"max_concurrency"`
+	MaxErrors       types.String                                 `tfsdk:"max_errors"`
+	Parameters      fwtypes.MapOfString                          `tfsdk:"parameters"`
+	Targets         fwtypes.ListNestedObjectValueOf[targetModel] `tfsdk:"targets"`
+	Timeout         types.Int64                                  `tfsdk:"timeout"`
+}
+
+type targetModel struct {
+	Key    types.String         `tfsdk:"key"`
+	Values fwtypes.ListOfString `tfsdk:"values"`
+}
+
+func (a *sendCommandAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an SSM document on managed instances using Run Command and waits for every invocation to finish. The action fails if any invocation fails.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrComment: schema.StringAttribute{
+				Description: "A user-specified description of the command",
+				Optional:    true,
+			},
+			"document_name": schema.StringAttribute{
+				Description: "The name or ARN of the SSM document to run",
+				Required:    true,
+			},
+			"document_version": schema.StringAttribute{
+				Description: "The version of the SSM document to run. Defaults to the default version of the document",
+				Optional:    true,
+			},
+			"instance_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Description: "The IDs of the managed instances to run the command on. Exactly one of instance_ids or targets must be specified",
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(1, 50),
+					setvalidator.ExactlyOneOf(path.MatchRoot("targets")),
+				},
+			},
+			"max_concurrency": schema.StringAttribute{
+				Description: "The maximum number (e.g. 10) or percentage (e.g. 10%) of managed instances that can run the command at the same time",
+				Optional:    true,
+			},
+			"max_errors": schema.StringAttribute{
+				Description: "The maximum number (e.g. 10) or percentage (e.g. 10%) of errors allowed before the command stops being sent to further instances",
+				Optional:    true,
+			},
+			names.AttrParameters: schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Description: "The parameters to pass to the SSM document",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for all invocations to finish. Defaults to 1800 seconds (30 minutes)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"targets": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[targetModel](ctx),
+				Description: "The targets, specified as tag or resource group key-value pairs, to run the command on",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(5),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Description: "The target key, e.g. tag:Environment or InstanceIds",
+							Required:    true,
+						},
+						names.AttrValues: schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Description: "The target values",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *sendCommandAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config sendCommandActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SSMClient(ctx)
+
+	documentName := config.DocumentName.ValueString()
+
+	timeout := 30 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting SSM send command action", map[string]any{
+		"document_name":   documentName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Sending command %s...", documentName),
+	})
+
+	input := ssm.SendCommandInput{
+		Comment:         fwflex.StringFromFramework(ctx, config.Comment),
+		DocumentName:    aws.String(documentName),
+		DocumentVersion: fwflex.StringFromFramework(ctx, config.DocumentVersion),
+		InstanceIds:     fwflex.ExpandFrameworkStringValueSet(ctx, config.InstanceIDs),
+		MaxConcurrency:  fwflex.StringFromFramework(ctx, config.MaxConcurrency),
+		MaxErrors:       fwflex.StringFromFramework(ctx, config.MaxErrors),
+	}
+
+	if !config.Parameters.IsNull() {
+		// Each document parameter is a list of strings, as in aws_ssm_association.
+		input.Parameters = tfmaps.ApplyToAllValues(fwflex.ExpandFrameworkStringValueMap(ctx, config.Parameters), func(v string) []string {
+			return []string{v}
+		})
+	}
+
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config.Targets, &input.Targets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.SendCommand(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError("Sending SSM command", err.Error())
+		return
+	}
+
+	commandID := aws.ToString(output.Command.CommandId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Command %s sent, waiting for invocations to finish...", commandID),
+	})
+
+	// Report each instance's status transitions as they are observed.
+	lastStatuses := make(map[string]awstypes.CommandInvocationStatus)
+
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Command], error) {
+		command, err := findCommandByID(ctx, conn, commandID)
+		if tfresource.NotFound(err) {
+			// ListCommands is eventually consistent, the command may not be visible immediately after it is sent.
+			return actionwait.FetchResult[*awstypes.Command]{Status: actionwait.Status(awstypes.CommandStatusPending)}, nil
+		}
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Command]{}, err
+		}
+
+		invocations, err := findCommandInvocationsByCommandID(ctx, conn, commandID, false)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Command]{}, err
+		}
+
+		for _, invocation := range invocations {
+			instanceID, status := aws.ToString(invocation.InstanceId), invocation.Status
+			if lastStatuses[instanceID] != status {
+				lastStatuses[instanceID] = status
+				resp.SendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("Instance %s is %s", instanceID, status),
+				})
+			}
+		}
+
+		return actionwait.FetchResult[*awstypes.Command]{Status: actionwait.Status(command.Status), Value: command}, nil
+	}, actionwait.Options[*awstypes.Command]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(sendCommandPollInterval),
+		ProgressInterval: 2 * time.Minute,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusSuccess),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusPending),
+			actionwait.Status(awstypes.CommandStatusInProgress),
+			actionwait.Status(awstypes.CommandStatusCancelling),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusCancelled),
+			actionwait.Status(awstypes.CommandStatusFailed),
+			actionwait.Status(awstypes.CommandStatusTimedOut),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Command %s is %s (elapsed %s)", commandID, fr.Status, meta.Elapsed.Round(time.Second)),
+			})
+		},
+	})
+
+	// On a terminal failure state, per-instance results are still reported below.
+	var failureErr *actionwait.FailureStateError
+	if err != nil && !errors.As(err, &failureErr) {
+		var timeoutErr *actionwait.TimeoutError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError("Command timeout", fmt.Sprintf("SSM command %s did not finish within %s", commandID, timeout))
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected command status", err.Error())
+		} else {
+			resp.Diagnostics.AddError("Error waiting for command", err.Error())
+		}
+		return
+	}
+
+	invocations, err := findCommandInvocationsByCommandID(ctx, conn, commandID, true)
+	if err != nil {
+		resp.Diagnostics.AddError("Listing SSM command invocations", err.Error())
+		return
+	}
+
+	var invocationFailures []string
+	for _, invocation := range invocations {
+		instanceID := aws.ToString(invocation.InstanceId)
+
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "Instance %s: %s", instanceID, invocation.Status)
+		if details := aws.ToString(invocation.StatusDetails); details != "" && details != string(invocation.Status) {
+			fmt.Fprintf(&sb, " (%s)", details)
+		}
+
+		for _, plugin := range invocation.CommandPlugins {
+			pluginName := aws.ToString(plugin.Name)
+
+			input := ssm.GetCommandInvocationInput{
+				CommandId:  aws.String(commandID),
+				InstanceId: aws.String(instanceID),
+				PluginName: plugin.Name,
+			}
+			output, err := conn.GetCommandInvocation(ctx, &input)
+			if err != nil {
+				// Fall back to the combined (truncated) output returned by ListCommandInvocations.
+				tflog.Warn(ctx, "Getting SSM command invocation", map[string]any{
+					"error": err.Error(),
+				})
+				if output := aws.ToString(plugin.Output); output != "" {
+					fmt.Fprintf(&sb, "\n[%s] output:\n%s", pluginName, truncateCommandOutput(output))
+				}
+				continue
+			}
+
+			if stdout := aws.ToString(output.StandardOutputContent); stdout != "" {
+				fmt.Fprintf(&sb, "\n[%s] stdout:\n%s", pluginName, truncateCommandOutput(stdout))
+			}
+			if stderr := aws.ToString(output.StandardErrorContent); stderr != "" {
+				fmt.Fprintf(&sb, "\n[%s] stderr:\n%s", pluginName, truncateCommandOutput(stderr))
+			}
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: sb.String(),
+		})
+
+		if invocation.Status != awstypes.CommandInvocationStatusSuccess {
+			invocationFailures = append(invocationFailures, sb.String())
+		}
+	}
+
+	if failureErr != nil || len(invocationFailures) > 0 {
+		detail := fmt.Sprintf("SSM command %s did not succeed on all instances", commandID)
+		if len(invocationFailures) > 0 {
+			detail += ":\n\n" + strings.Join(invocationFailures, "\n\n")
+		}
+		resp.Diagnostics.AddError("Command failed", detail)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Command %s completed successfully on %d instance(s)", commandID, len(invocations)),
+	})
+
+	tflog.Info(ctx, "SSM send command action completed successfully", map[string]any{
+		"command_id":    commandID,
+		"document_name": documentName,
+	})
+}
+
+func findCommandByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.Command, error) {
+	input := ssm.ListCommandsInput{
+		CommandId: aws.String(id),
+	}
+
+	output, err := conn.ListCommands(ctx, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.Commands)
+}
+
+func findCommandInvocationsByCommandID(ctx context.Context, conn *ssm.Client, id string, details bool) ([]awstypes.CommandInvocation, error) {
+	input := ssm.ListCommandInvocationsInput{
+		CommandId: aws.String(id),
+		Details:   details,
+	}
+	var output []awstypes.CommandInvocation
+
+	pages := ssm.NewListCommandInvocationsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CommandInvocations...)
+	}
+
+	return output, nil
+}
+
+// truncateCommandOutput truncates command output for inclusion in progress messages.
+func truncateCommandOutput(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) <= sendCommandOutputMaxLength {
+		return s
+	}
+
+	// Don't split a multi-byte character.
+	n := sendCommandOutputMaxLength
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+
+	return s[:n] + "... (truncated)"
+}
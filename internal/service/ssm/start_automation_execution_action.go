@@ -0,0 +1,335 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// startAutomationExecutionPollInterval defines polling cadence for the start automation execution action.
+const startAutomationExecutionPollInterval = 10 * time.Second
+
+// @Action(aws_ssm_start_automation_execution, name="Start Automation Execution")
+func newStartAutomationExecutionAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startAutomationExecutionAction{}, nil
+}
+
+var (
+	_ action.Action = (*startAutomationExecutionAction)(nil)
+)
+
+type startAutomationExecutionAction struct {
+	framework.ActionWithModel[startAutomationExecutionActionModel]
+}
+
+type startAutomationExecutionActionModel struct {
+	framework.WithRegionModel
+	DocumentName        types.String                                 `tfsdk:"document_name"`
+	DocumentVersion     types.String                                 `tfsdk:"document_version"`
+	MaxConcurrency      types.String                                 `tfsdk:"max_concurrency"`
+	MaxErrors           types.String                                 `tfsdk:"max_errors"`
+	Parameters          fwtypes.MapOfString                          `tfsdk:"parameters"`
+	TargetParameterName types.String                                 `tfsdk:"target_parameter_name"`
+	Targets             fwtypes.ListNestedObjectValueOf[targetModel] `tfsdk:"targets"`
+	Timeout             types.Int64                                  `tfsdk:"timeout"`
+}
+
+func (a *startAutomationExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an SSM Automation runbook execution and waits for it to complete, reporting the progress of each step.",
+		Attributes: map[string]schema.Attribute{
+			"document_name": schema.StringAttribute{
+				Description: "The name or ARN of the Automation runbook to run",
+				Required:    true,
+			},
+			"document_version": schema.StringAttribute{
+				Description: "The version of the Automation runbook to run. Defaults to the default version of the runbook",
+				Optional:    true,
+			},
+			"max_concurrency": schema.StringAttribute{
+				Description: "The maximum number (e.g. 10) or percentage (e.g. 10%) of targets the runbook can run on at the same time. Only used with targets",
+				Optional:    true,
+			},
+			"max_errors": schema.StringAttribute{
+				Description: "The maximum number (e.g. 10) or percentage (e.g. 10%) of errors allowed before the runbook stops running on further targets. Only used with targets",
+				Optional:    true,
+			},
+			names.AttrParameters: schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Description: "The input parameters to pass to the Automation runbook",
+				Optional:    true,
+			},
+			"target_parameter_name": schema.StringAttribute{
+				Description: "The name of the runbook parameter that receives each target's resource ID when running a rate-controlled execution",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the automation execution to complete. Defaults to 3600 seconds (60 minutes)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"targets": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[targetModel](ctx),
+				Description: "The targets, specified as tag or resource group key-value pairs, to run the runbook on",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(5),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Description: "The target key, e.g. tag:Environment or ParameterValues",
+							Required:    true,
+						},
+						names.AttrValues: schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Description: "The target values",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *startAutomationExecutionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startAutomationExecutionActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SSMClient(ctx)
+
+	documentName := config.DocumentName.ValueString()
+
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting SSM start automation execution action", map[string]any{
+		"document_name":   documentName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting automation execution of %s...", documentName),
+	})
+
+	input := ssm.StartAutomationExecutionInput{
+		DocumentName:        aws.String(documentName),
+		DocumentVersion:     fwflex.StringFromFramework(ctx, config.DocumentVersion),
+		MaxConcurrency:      fwflex.StringFromFramework(ctx, config.MaxConcurrency),
+		MaxErrors:           fwflex.StringFromFramework(ctx, config.MaxErrors),
+		TargetParameterName: fwflex.StringFromFramework(ctx, config.TargetParameterName),
+	}
+
+	if !config.Parameters.IsNull() {
+		// Each runbook parameter is a list of strings, as in aws_ssm_association.
+		input.Parameters = tfmaps.ApplyToAllValues(fwflex.ExpandFrameworkStringValueMap(ctx, config.Parameters), func(v string) []string {
+			return []string{v}
+		})
+	}
+
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config.Targets, &input.Targets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.StartAutomationExecution(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError("Starting SSM automation execution", err.Error())
+		return
+	}
+
+	executionID := aws.ToString(output.AutomationExecutionId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Automation execution %s started, waiting for completion...", executionID),
+	})
+
+	// Report each step's status transitions as they are observed.
+	lastStepStatuses := make(map[string]awstypes.AutomationExecutionStatus)
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.AutomationExecution], error) {
+		execution, err := findAutomationExecutionByID(ctx, conn, executionID)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.AutomationExecution]{}, err
+		}
+
+		for _, step := range execution.StepExecutions {
+			stepID := aws.ToString(step.StepExecutionId)
+			if lastStepStatuses[stepID] != step.StepStatus {
+				lastStepStatuses[stepID] = step.StepStatus
+				resp.SendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("Step %s (%s) is %s", aws.ToString(step.StepName), aws.ToString(step.Action), step.StepStatus),
+				})
+			}
+		}
+
+		return actionwait.FetchResult[*awstypes.AutomationExecution]{Status: actionwait.Status(execution.AutomationExecutionStatus), Value: execution}, nil
+	}, actionwait.Options[*awstypes.AutomationExecution]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startAutomationExecutionPollInterval),
+		ProgressInterval: 2 * time.Minute,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.AutomationExecutionStatusSuccess),
+			actionwait.Status(awstypes.AutomationExecutionStatusCompletedWithSuccess),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.AutomationExecutionStatusPending),
+			actionwait.Status(awstypes.AutomationExecutionStatusInprogress),
+			actionwait.Status(awstypes.AutomationExecutionStatusWaiting),
+			actionwait.Status(awstypes.AutomationExecutionStatusCancelling),
+			actionwait.Status(awstypes.AutomationExecutionStatusPendingApproval),
+			actionwait.Status(awstypes.AutomationExecutionStatusApproved),
+			actionwait.Status(awstypes.AutomationExecutionStatusScheduled),
+			actionwait.Status(awstypes.AutomationExecutionStatusRunbookInprogress),
+			actionwait.Status(awstypes.AutomationExecutionStatusPendingChangeCalendarOverride),
+			actionwait.Status(awstypes.AutomationExecutionStatusChangeCalendarOverrideApproved),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.AutomationExecutionStatusFailed),
+			actionwait.Status(awstypes.AutomationExecutionStatusTimedout),
+			actionwait.Status(awstypes.AutomationExecutionStatusCancelled),
+			actionwait.Status(awstypes.AutomationExecutionStatusRejected),
+			actionwait.Status(awstypes.AutomationExecutionStatusChangeCalendarOverrideRejected),
+			actionwait.Status(awstypes.AutomationExecutionStatusCompletedWithFailure),
+			actionwait.Status(awstypes.AutomationExecutionStatusExited),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Automation execution %s is %s (elapsed %s)", executionID, fr.Status, meta.Elapsed.Round(time.Second)),
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			detail := fmt.Sprintf("Automation execution %s did not complete within %s", executionID, timeout)
+			// Executions paused on an aws:approve or aws:pause step stay in Waiting until acted on.
+			if execution := result.Value; execution != nil && execution.AutomationExecutionStatus == awstypes.AutomationExecutionStatusWaiting {
+				detail += fmt.Sprintf("; it is waiting on step %s (%s)", aws.ToString(execution.CurrentStepName), aws.ToString(execution.CurrentAction))
+			}
+			resp.Diagnostics.AddError("Automation execution timeout", detail)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError("Automation execution failed", automationExecutionFailureDetail(result.Value))
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError("Unexpected automation execution status", err.Error())
+		} else {
+			resp.Diagnostics.AddError("Error waiting for automation execution", err.Error())
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Automation execution %s completed successfully", executionID),
+	})
+
+	tflog.Info(ctx, "SSM start automation execution action completed successfully", map[string]any{
+		"automation_execution_id": executionID,
+		"document_name":           documentName,
+	})
+}
+
+func findAutomationExecutionByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.AutomationExecution, error) {
+	input := ssm.GetAutomationExecutionInput{
+		AutomationExecutionId: aws.String(id),
+	}
+
+	output, err := conn.GetAutomationExecution(ctx, &input)
+
+	if errs.IsA[*awstypes.AutomationExecutionNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AutomationExecution == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return output.AutomationExecution, nil
+}
+
+// automationExecutionFailureDetail returns the execution's failure message and the details of its failed steps.
+func automationExecutionFailureDetail(execution *awstypes.AutomationExecution) string {
+	if execution == nil {
+		return "automation execution failed"
+	}
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Automation execution %s is %s", aws.ToString(execution.AutomationExecutionId), execution.AutomationExecutionStatus)
+	if message := aws.ToString(execution.FailureMessage); message != "" {
+		fmt.Fprintf(&sb, ": %s", message)
+	}
+
+	failedStepStatuses := []awstypes.AutomationExecutionStatus{
+		awstypes.AutomationExecutionStatusFailed,
+		awstypes.AutomationExecutionStatusTimedout,
+		awstypes.AutomationExecutionStatusCancelled,
+	}
+
+	for _, step := range execution.StepExecutions {
+		if !slices.Contains(failedStepStatuses, step.StepStatus) {
+			continue
+		}
+
+		fmt.Fprintf(&sb, "\n\nStep %s (%s) is %s", aws.ToString(step.StepName), aws.ToString(step.Action), step.StepStatus)
+		if message := aws.ToString(step.FailureMessage); message != "" {
+			fmt.Fprintf(&sb, ": %s", message)
+		}
+
+		if details := step.FailureDetails; details != nil {
+			fmt.Fprintf(&sb, "\nFailure type: %s, stage: %s", aws.ToString(details.FailureType), aws.ToString(details.FailureStage))
+			for _, k := range slices.Sorted(maps.Keys(details.Details)) {
+				fmt.Fprintf(&sb, "\n%s: %s", k, strings.Join(details.Details[k], ", "))
+			}
+		}
+	}
+
+	return sb.String()
+}
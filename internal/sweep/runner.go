@@ -0,0 +1,356 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+)
+
+const (
+	defaultSweepParallelism = 10
+)
+
+// sweepers holds all registered sweepers.
+// The terraform-plugin-testing registry is not exported, so sweepers are also recorded here.
+var sweepers = make(map[string]*resource.Sweeper)
+
+// AddTestSweepers registers a sweeper.
+// Sweepers registered here are run by TestMain.
+// A sweeper's Dependencies are the sweepers which must run, and succeed, before it.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	resource.AddTestSweepers(name, s)
+
+	sweepers[name] = s
+}
+
+// TestMain adds dependency-aware parallel sweeper functionality to the "go test" command, otherwise
+// tests are executed as normal.
+//
+// The sweeper flags are those of terraform-plugin-testing's resource.TestMain, plus:
+//
+//	-sweep-parallelism: Maximum number of sweepers to run concurrently. Defaults to 10.
+func TestMain(m interface{ Run() int }) {
+	flagSweepParallelism := flag.Int("sweep-parallelism", defaultSweepParallelism, "Maximum number of Sweepers to run concurrently")
+
+	flag.Parse()
+
+	regions := flagValue("sweep")
+	if regions == "" {
+		resource.TestMain(m)
+		return
+	}
+
+	allowFailures, _ := strconv.ParseBool(flagValue("sweep-allow-failures"))
+
+	if err := runSweepers(strings.Split(regions, ","), flagValue("sweep-run"), *flagSweepParallelism, allowFailures); err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] %s\n", err)
+		os.Exit(1)
+	}
+}
+
+// flagValue returns the value of a flag defined by terraform-plugin-testing.
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
+
+func runSweepers(regions []string, filter string, parallelism int, allowFailures bool) error {
+	g, err := newSweeperGraph(sweepers)
+	if err != nil {
+		return err
+	}
+
+	g, err = g.filter(filter)
+	if err != nil {
+		return err
+	}
+
+	var sweeperErrorFound bool
+
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+		ctx := Context(region)
+
+		start := time.Now()
+		tflog.Info(ctx, "Running sweepers", map[string]any{
+			"sweeper_count": g.graph.Len(),
+			"parallelism":   parallelism,
+		})
+
+		results, err := g.run(ctx, region, parallelism, allowFailures)
+		if err != nil {
+			return err
+		}
+
+		tflog.Info(ctx, "Completed sweepers", map[string]any{
+			"duration": time.Since(start).String(),
+		})
+
+		names := slices.Sorted(maps.Keys(results))
+
+		for _, name := range names {
+			if err := results[name]; err != nil {
+				sweeperErrorFound = true
+				tflog.Error(ctx, "Sweeper failed", map[string]any{
+					"sweeper": name,
+					"error":   err.Error(),
+				})
+			} else {
+				tflog.Info(ctx, "Sweeper succeeded", map[string]any{
+					"sweeper": name,
+				})
+			}
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(g.sweepers)) {
+			if _, ok := results[name]; !ok {
+				tflog.Warn(ctx, "Sweeper not run", map[string]any{
+					"sweeper": name,
+				})
+			}
+		}
+
+		if sweeperErrorFound && !allowFailures {
+			return fmt.Errorf("sweepers for region (%s) failed", region)
+		}
+	}
+
+	if sweeperErrorFound {
+		return errors.New("at least one sweeper failed")
+	}
+
+	return nil
+}
+
+// sweeperGraph is the dependency graph of a set of sweepers.
+// An edge from A to B means that sweeper B must run before sweeper A,
+// typically because B's resources (e.g. subnets) block deletion of A's resources (e.g. VPCs).
+type sweeperGraph struct {
+	graph    *depgraph.Graph
+	sweepers map[string]*resource.Sweeper
+}
+
+// newSweeperGraph returns the dependency graph of the specified sweepers.
+// Returns an error if a sweeper has an unregistered dependency or a dependency cycle is detected.
+func newSweeperGraph(sweepers map[string]*resource.Sweeper) (*sweeperGraph, error) {
+	g := depgraph.New()
+
+	names := slices.Sorted(maps.Keys(sweepers))
+
+	for _, name := range names {
+		g.AddNode(name)
+	}
+
+	for _, name := range names {
+		for _, dependency := range sweepers[name].Dependencies {
+			if err := g.AddDependency(name, dependency); err != nil {
+				return nil, fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency)
+			}
+		}
+	}
+
+	if _, err := g.OverallOrder(); err != nil {
+		return nil, fmt.Errorf("validating sweeper dependencies: %w", err)
+	}
+
+	return &sweeperGraph{
+		graph:    g,
+		sweepers: sweepers,
+	}, nil
+}
+
+// filter returns the subgraph of sweepers whose names contain (case-insensitively) any of the
+// comma-separated values in f, together with all their transitive dependencies.
+// An empty filter returns the whole graph.
+func (g *sweeperGraph) filter(f string) (*sweeperGraph, error) {
+	if f == "" {
+		return g, nil
+	}
+
+	filters := strings.Split(strings.ToLower(f), ",")
+	selected := make(map[string]*resource.Sweeper)
+
+	for _, name := range slices.Sorted(maps.Keys(g.sweepers)) {
+		if !slices.ContainsFunc(filters, func(s string) bool {
+			return strings.Contains(strings.ToLower(name), s)
+		}) {
+			continue
+		}
+
+		dependencies, err := g.graph.DependenciesOf(name)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range append(dependencies, name) {
+			selected[name] = g.sweepers[name]
+		}
+	}
+
+	return newSweeperGraph(selected)
+}
+
+// levels returns the sweepers grouped so that every sweeper's dependencies are in an earlier group.
+// Sweepers in the same group are independent of one another and can run concurrently.
+func (g *sweeperGraph) levels() ([][]string, error) {
+	order, err := g.graph.OverallOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	// OverallOrder lists dependencies before their dependents.
+	depth := make(map[string]int, len(order))
+	var levels [][]string
+
+	for _, name := range order {
+		dependencies, err := g.graph.DirectDependenciesOf(name)
+		if err != nil {
+			return nil, err
+		}
+
+		level := 0
+		for _, dependency := range dependencies {
+			level = max(level, depth[dependency]+1)
+		}
+		depth[name] = level
+
+		if level == len(levels) {
+			levels = append(levels, nil)
+		}
+		levels[level] = append(levels[level], name)
+	}
+
+	for _, level := range levels {
+		slices.Sort(level)
+	}
+
+	return levels, nil
+}
+
+// run runs the sweepers in the specified region level by level, running at most parallelism sweepers concurrently.
+// Unless allowFailures is set, no further levels are run after a level in which a sweeper failed.
+// A sweeper with failed (transitive) dependencies is still run, and if it too fails the failed
+// dependencies are reported as possibly blocking deletion of its resources.
+// Returns each sweeper's result, keyed by sweeper name.
+func (g *sweeperGraph) run(ctx context.Context, region string, parallelism int, allowFailures bool) (map[string]error, error) {
+	levels, err := g.levels()
+	if err != nil {
+		return nil, err
+	}
+
+	parallelism = max(parallelism, 1)
+	results := make(map[string]error, g.graph.Len())
+
+	for i, level := range levels {
+		tflog.Debug(ctx, "Running sweeper level", map[string]any{
+			"level":    i,
+			"sweepers": level,
+		})
+
+		var (
+			mu  sync.Mutex
+			wg  sync.WaitGroup
+			sem = make(chan struct{}, parallelism)
+		)
+
+		for _, name := range level {
+			wg.Add(1)
+			sem <- struct{}{}
+
+			go func() {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+
+				tflog.Debug(ctx, "Running sweeper", map[string]any{
+					"sweeper": name,
+				})
+
+				start := time.Now()
+				err := g.sweepers[name].F(region)
+
+				tflog.Debug(ctx, "Completed sweeper", map[string]any{
+					"sweeper":  name,
+					"duration": time.Since(start).String(),
+				})
+
+				mu.Lock()
+				defer mu.Unlock()
+
+				results[name] = err
+			}()
+		}
+
+		wg.Wait()
+
+		var levelErrorFound bool
+
+		for _, name := range level {
+			if results[name] == nil {
+				continue
+			}
+
+			levelErrorFound = true
+
+			dependencies, err := g.graph.DependenciesOf(name)
+			if err != nil {
+				return nil, err
+			}
+
+			var blockedBy []string
+			for _, dependency := range dependencies {
+				if results[dependency] != nil {
+					blockedBy = append(blockedBy, dependency)
+				}
+			}
+
+			if len(blockedBy) > 0 {
+				slices.Sort(blockedBy)
+				results[name] = &blockedError{
+					err:          results[name],
+					dependencies: blockedBy,
+				}
+			}
+		}
+
+		if levelErrorFound && !allowFailures {
+			break
+		}
+	}
+
+	return results, nil
+}
+
+// blockedError is returned for a failed sweeper whose dependencies also failed.
+// Resources left behind by the failed dependencies may have blocked deletion.
+type blockedError struct {
+	err          error
+	dependencies []string
+}
+
+func (e *blockedError) Error() string {
+	return fmt.Sprintf("%s (possibly blocked by failed sweepers: %s)", e.err, strings.Join(e.dependencies, ", "))
+}
+
+func (e *blockedError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,316 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+type testSweepers struct {
+	mu       sync.Mutex
+	ran      []string
+	failures map[string]bool
+	sweepers map[string]*resource.Sweeper
+}
+
+func newTestSweepers() *testSweepers {
+	return &testSweepers{
+		failures: make(map[string]bool),
+		sweepers: make(map[string]*resource.Sweeper),
+	}
+}
+
+func (s *testSweepers) add(name string, dependencies ...string) {
+	s.sweepers[name] = &resource.Sweeper{
+		Name: name,
+		F: func(string) error {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			s.ran = append(s.ran, name)
+			if s.failures[name] {
+				return errors.New("failed")
+			}
+			return nil
+		},
+		Dependencies: dependencies,
+	}
+}
+
+func TestSweeperGraphLevels(t *testing.T) {
+	t.Parallel()
+
+	s := newTestSweepers()
+	s.add("aws_vpc", "aws_subnet", "aws_internet_gateway")
+	s.add("aws_subnet", "aws_instance", "aws_network_interface")
+	s.add("aws_internet_gateway")
+	s.add("aws_instance")
+	s.add("aws_network_interface", "aws_instance")
+	s.add("aws_s3_bucket")
+
+	g, err := newSweeperGraph(s.sweepers)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := g.levels()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := [][]string{
+		{"aws_instance", "aws_internet_gateway", "aws_s3_bucket"},
+		{"aws_network_interface"},
+		{"aws_subnet"},
+		{"aws_vpc"},
+	}
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestNewSweeperGraphErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		setup         func(*testSweepers)
+		expectedError string
+	}{
+		"missing dependency": {
+			setup: func(s *testSweepers) {
+				s.add("aws_vpc", "aws_subnet")
+			},
+			expectedError: "sweeper (aws_vpc) has dependency (aws_subnet), but that sweeper was not found",
+		},
+		"cycle": {
+			setup: func(s *testSweepers) {
+				s.add("aws_a", "aws_b")
+				s.add("aws_b", "aws_c")
+				s.add("aws_c", "aws_a")
+			},
+			expectedError: "dependency cycle",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newTestSweepers()
+			testCase.setup(s)
+
+			_, err := newSweeperGraph(s.sweepers)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !strings.Contains(err.Error(), testCase.expectedError) {
+				t.Errorf("expected error containing %q, got %q", testCase.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestSweeperGraphFilter(t *testing.T) {
+	t.Parallel()
+
+	s := newTestSweepers()
+	s.add("aws_vpc", "aws_subnet")
+	s.add("aws_subnet", "aws_instance")
+	s.add("aws_instance")
+	s.add("aws_s3_bucket")
+	s.add("aws_s3_object")
+
+	g, err := newSweeperGraph(s.sweepers)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		filter   string
+		expected [][]string
+	}{
+		"no filter": {
+			expected: [][]string{
+				{"aws_instance", "aws_s3_bucket", "aws_s3_object"},
+				{"aws_subnet"},
+				{"aws_vpc"},
+			},
+		},
+		"with dependencies": {
+			filter: "AWS_Subnet",
+			expected: [][]string{
+				{"aws_instance"},
+				{"aws_subnet"},
+			},
+		},
+		"multiple": {
+			filter: "vpc,aws_s3",
+			expected: [][]string{
+				{"aws_instance", "aws_s3_bucket", "aws_s3_object"},
+				{"aws_subnet"},
+				{"aws_vpc"},
+			},
+		},
+		"no match": {
+			filter: "aws_lambda",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			g, err := g.filter(testCase.filter)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := g.levels()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSweeperGraphRun(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		failures      []string
+		allowFailures bool
+		expectedRan   []string
+		expectedErrs  map[string]string
+	}{
+		"success": {
+			expectedRan:  []string{"aws_instance", "aws_internet_gateway", "aws_subnet", "aws_vpc"},
+			expectedErrs: map[string]string{},
+		},
+		"failure": {
+			failures:    []string{"aws_instance"},
+			expectedRan: []string{"aws_instance", "aws_internet_gateway"},
+			expectedErrs: map[string]string{
+				"aws_instance": "failed",
+			},
+		},
+		"failure allowed": {
+			failures:      []string{"aws_instance", "aws_vpc"},
+			allowFailures: true,
+			expectedRan:   []string{"aws_instance", "aws_internet_gateway", "aws_subnet", "aws_vpc"},
+			expectedErrs: map[string]string{
+				"aws_instance": "failed",
+				"aws_vpc":      "failed (possibly blocked by failed sweepers: aws_instance)",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newTestSweepers()
+			s.add("aws_vpc", "aws_subnet", "aws_internet_gateway")
+			s.add("aws_subnet", "aws_instance")
+			s.add("aws_internet_gateway")
+			s.add("aws_instance")
+			for _, name := range testCase.failures {
+				s.failures[name] = true
+			}
+
+			g, err := newSweeperGraph(s.sweepers)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			results, err := g.run(t.Context(), "us-west-2", 2, testCase.allowFailures)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			// The two sweepers in the first level run in any order.
+			slices.Sort(s.ran[:2])
+			if diff := cmp.Diff(s.ran, testCase.expectedRan); diff != "" {
+				t.Errorf("unexpected sweepers run diff (+wanted, -got): %s", diff)
+			}
+
+			errs := make(map[string]string)
+			for name, err := range results {
+				if err != nil {
+					errs[name] = err.Error()
+				}
+			}
+			if diff := cmp.Diff(errs, testCase.expectedErrs); diff != "" {
+				t.Errorf("unexpected errors diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSweeperGraphRunSharedClient(t *testing.T) {
+	t.Parallel()
+
+	const sweeperCount = 10
+
+	var created atomic.Int32
+	newClient := func(context.Context, string) (*conns.AWSClient, error) {
+		created.Add(1)
+		return new(conns.AWSClient), nil
+	}
+
+	cache := newClientCache()
+	clients := make([]*conns.AWSClient, sweeperCount)
+	sweepers := make(map[string]*resource.Sweeper, sweeperCount)
+	for i := range sweeperCount {
+		name := fmt.Sprintf("aws_sweeper_%d", i)
+		sweepers[name] = &resource.Sweeper{
+			Name: name,
+			F: func(region string) error {
+				client, err := cache.get(t.Context(), region, newClient)
+				clients[i] = client
+				return err
+			},
+		}
+	}
+
+	g, err := newSweeperGraph(sweepers)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	results, err := g.run(t.Context(), "us-west-2", sweeperCount, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for name, err := range results {
+		if err != nil {
+			t.Errorf("sweeper %s: unexpected error: %s", name, err)
+		}
+	}
+
+	if got, want := created.Load(), int32(1); got != want {
+		t.Errorf("clients created = %d, want %d", got, want)
+	}
+
+	for i, client := range clients {
+		if client != clients[0] {
+			t.Errorf("sweeper %d: client not shared", i)
+		}
+	}
+}
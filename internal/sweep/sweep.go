@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
@@ -29,14 +30,46 @@ var ServicePackages []conns.ServicePackage
 
 // sweeperClients is a shared cache of regional conns.AWSClient
 // This prevents client re-initialization for every resource with no benefit.
-var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+var sweeperClients = newClientCache()
 
-// SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
-func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
-	if client, ok := sweeperClients[region]; ok {
+// clientCache is a cache of regional conns.AWSClient that is safe for use by sweepers running concurrently.
+type clientCache struct {
+	mu      sync.Mutex
+	clients map[string]*conns.AWSClient
+}
+
+func newClientCache() *clientCache {
+	return &clientCache{
+		clients: make(map[string]*conns.AWSClient),
+	}
+}
+
+// get returns the cached client for the specified Region, calling newClient to create it if not yet cached.
+// The lock is held while the client is created so that concurrent callers share a single client.
+func (c *clientCache) get(ctx context.Context, region string, newClient func(context.Context, string) (*conns.AWSClient, error)) (*conns.AWSClient, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if client, ok := c.clients[region]; ok {
 		return client, nil
 	}
 
+	client, err := newClient(ctx, region)
+	if err != nil {
+		return nil, err
+	}
+
+	c.clients[region] = client
+
+	return client, nil
+}
+
+// SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
+func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
+	return sweeperClients.get(ctx, region, newRegionalSweepClient)
+}
+
+func newRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
 	_, _, err := envvar.RequireOneOf([]string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running sweepers")
 	if err != nil {
 		return nil, err
@@ -95,8 +128,6 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 		return nil, fmt.Errorf("getting AWS client: %#v", diags)
 	}
 
-	sweeperClients[region] = client
-
 	return client, nil
 }
 